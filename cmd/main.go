@@ -2,38 +2,171 @@ package main
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
 
+	"github.com/adrg/xdg"
+	"github.com/mattn/go-isatty"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	configentity "project-helper/internal/config"
+	"project-helper/internal/domain/entity"
 	"project-helper/internal/service/arg"
 	"project-helper/internal/service/arg/enhance"
 	"project-helper/internal/service/arg/predefined"
+	"project-helper/internal/service/arg/source"
+	"project-helper/internal/service/command"
+	appscommand "project-helper/internal/service/command/apps"
+	argscommand "project-helper/internal/service/command/args"
+	configcommand "project-helper/internal/service/command/config"
+	flagscommand "project-helper/internal/service/command/flags"
 	"project-helper/internal/service/config"
 	"project-helper/internal/service/flag"
 	"project-helper/internal/service/flag/parser"
+	"project-helper/internal/service/flag/prompt"
 	"project-helper/internal/service/operation"
 	"project-helper/internal/service/projecthelper"
+	"project-helper/internal/service/state"
 	"project-helper/internal/service/tag"
+	"project-helper/internal/service/tag/additional"
+	"project-helper/internal/service/tag/builtin"
+	"project-helper/internal/service/tag/env"
 	"project-helper/internal/service/tag/extractor"
+	"project-helper/internal/service/tag/file"
+	"project-helper/internal/service/tag/filter"
+	flagstag "project-helper/internal/service/tag/flags"
+	"project-helper/internal/service/tag/reference"
+	"project-helper/internal/service/tag/resource"
+	"project-helper/internal/service/tag/secret"
+	"project-helper/internal/service/terminal"
+	"project-helper/internal/service/vars"
 )
 
 func main() {
-	configService, err := config.NewService()
+	// Interrupting cancels the run, so running commands stop and temporary directories are still removed.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tagExtractorService := extractor.NewService()
+	filterService := filter.NewService()
+	envTagService := env.NewService()
+
+	// Config paths are resolved while the config loads, so only env tags are available.
+	pathTagService := tag.NewService()
+	pathTagService.Register(entity.EnvTagNamespace, envTagService)
+
+	configService, err := config.NewService(enhance.NewPathService(tagExtractorService, pathTagService, filterService))
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create config service")
 	}
 
-	flagParserService := parser.NewService(configService)
+	application, args := parser.ExtractApplicationFlag(os.Args[1:])
+
+	selectErr := configService.SelectApplication(application)
+
+	// Commands of this service don't need a selected application.
+	applicationCommandService := command.NewService()
+	applicationCommandService.Register(entity.AppsCommand, appscommand.NewService(configService, os.Stdout))
+
+	if cmd, cmdArgs, ok := applicationCommandService.Find(args); ok {
+		if selectErr != nil {
+			log.Warn().Err(selectErr).Msg("no application selected")
+		}
+
+		if err = cmd.Run(ctx, cmdArgs); err != nil {
+			log.Fatal().Err(err).Msgf("failed to run command %s", args[0])
+		}
+
+		return
+	}
+
+	if selectErr != nil {
+		fatalConfigErrors(configService, selectErr, "failed to select application")
+	}
+
+	stateService := state.NewService(state.GetApplicationDirectory(xdg.StateHome, configService.GetConfig().Name))
+
+	flagParserService := parser.NewService(configService, stateService)
+	sourceService := source.NewService(configService, state.GetApplicationDirectory(xdg.CacheHome, configService.GetConfig().Name))
+	predefinedArgService := predefined.NewService(configService, sourceService, tagExtractorService)
+	builtinService := builtin.NewService(configService)
+	resourceService := resource.NewService()
+	flagsTagService := flagstag.NewService()
+
+	referenceService := reference.NewService(tagExtractorService)
+
+	varService := vars.NewService(configService, stateService, tagExtractorService)
+
+	// Plain tags are looked up in this order, namespaced tags only by the provider of their namespace.
+	tagService := tag.NewService()
+	tagService.Register("", varService)
+	tagService.Register(entity.VarTagNamespace, varService)
+	tagService.Register("", flagsTagService)
+	tagService.Register(entity.FlagTagNamespace, flagsTagService)
+	tagService.Register(entity.EnvTagNamespace, envTagService)
+	tagService.Register("", additional.NewService(configService))
+	tagService.Register("", builtinService)
+	tagService.Register("", resourceService)
+	tagService.Register(entity.GitTagNamespace, builtin.NewGitProvider(builtinService))
+	tagService.Register(entity.FileTagNamespace, tag.Memoize(file.NewService(configService, file.Raw)))
+	tagService.Register(entity.JSONTagNamespace, tag.Memoize(file.NewService(configService, file.JSON)))
+	tagService.Register(entity.YAMLTagNamespace, tag.Memoize(file.NewService(configService, file.YAML)))
+	tagService.Register(entity.SecretTagNamespace, tag.Memoize(secret.NewService(filepath.Join(xdg.ConfigHome, "project-helper", "secrets"))))
+
+	enhanceArgService := enhance.NewService(tagExtractorService, tagService, predefinedArgService, filterService)
+
+	commandService := command.NewService()
+	commandService.Register(entity.FlagsCommand, flagscommand.NewService(stateService, os.Stdout))
+	commandService.Register(entity.ArgsCommand, argscommand.NewService(
+		configService, sourceService, predefinedArgService, referenceService, flagParserService, enhanceArgService, os.Stdout,
+	))
+	commandService.Register(entity.ConfigCommand, configcommand.NewService(
+		configService, tagExtractorService, referenceService, sourceService, predefinedArgService, varService, enhanceArgService, os.Stdout,
+	))
+
+	if cmd, cmdArgs, ok := commandService.Find(args); ok {
+		err = cmd.Run(ctx, cmdArgs)
+
+		if cleanupErr := resourceService.Cleanup(); cleanupErr != nil {
+			log.Error().Err(cleanupErr).Msg("failed to clean up temporary directories")
+		}
+
+		if err != nil {
+			log.Fatal().Err(err).Msgf("failed to run command %s", args[0])
+		}
+
+		return
+	}
+
+	// ph commands are matched first, so an operation named like one could never run.
+	if err = configService.GetConfig().ValidateOperationNames(); err != nil {
+		fatalConfigErrors(configService, err, "operations can't be dispatched")
+	}
 
 	flags, err := flagParserService.ParseFlags()
 	if err != nil {
-		log.Fatal().Err(err).Msg("failed to read flags")
+		fatalConfigErrors(configService, err, "failed to read flags")
 	}
 
 	flagsService := flag.NewFlagsService(flags)
-	tagExtractorService := extractor.NewService()
-	predefinedArgService := predefined.NewService(configService)
-	tagService := tag.NewService(configService)
-	enhanceArgService := enhance.NewService(tagExtractorService, tagService, predefinedArgService)
+
+	if err = sourceService.ValidateSources(); err != nil {
+		fatalConfigErrors(configService, err, "failed to validate config predefined args")
+	}
+
+	if err = predefinedArgService.ValidateTypes(); err != nil {
+		fatalConfigErrors(configService, err, "failed to validate config predefined arg types")
+	}
+
+	if err = varService.ValidateVars(); err != nil {
+		fatalConfigErrors(configService, err, "failed to validate config vars")
+	}
+
+	if err = enhanceArgService.ValidateArgs(configService.GetConfig().GetTaggedValues()); err != nil {
+		fatalConfigErrors(configService, err, "failed to validate config tags")
+	}
 
 	argService := arg.NewService(flagsService, enhanceArgService, predefinedArgService)
 
@@ -42,13 +175,42 @@ func main() {
 		log.Fatal().Err(err).Msg("failed to create operation service")
 	}
 
-	service := projecthelper.NewService(operationService, flagsService, argService)
+	terminalService := terminal.NewService(terminal.NewContextReader(ctx, os.Stdin), os.Stderr, !flags.NoInput && isatty.IsTerminal(os.Stdin.Fd()))
+	promptService := prompt.NewService(configService, sourceService, referenceService, terminalService)
+
+	service := projecthelper.NewService(operationService, flagsService, flagParserService, argService, promptService, tagExtractorService)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create operation service")
 	}
 
-	err = service.Run(context.Background())
+	err = service.Run(ctx)
+
+	if !flags.KeepTmp {
+		if cleanupErr := resourceService.Cleanup(); cleanupErr != nil {
+			log.Error().Err(cleanupErr).Msg("failed to clean up temporary directories")
+		}
+	}
+
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to run operation")
 	}
 }
+
+// fatalConfigErrors logs every problem found by a validator at the position
+// of its config entry and exits.
+func fatalConfigErrors(configService *config.Service, err error, message string) {
+	var configErrors configentity.Errors
+	if !errors.As(err, &configErrors) {
+		log.Fatal().Err(err).Msg(message)
+	}
+
+	for _, configError := range configErrors {
+		log.Error().
+			Err(configError.Err).
+			Str("config.path", configError.Path).
+			Stringer("config.position", configService.GetPosition(configError.Path)).
+			Msg(message)
+	}
+
+	log.Fatal().Int("config.errors", len(configErrors)).Msg(message)
+}
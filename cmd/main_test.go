@@ -39,7 +39,7 @@ func TestIntegration(t *testing.T) {
 			},
 			{
 				Name: "dynamic-flag",
-				Type: entity.Array,
+				Type: entity.String,
 				Args: config.Args{
 					{
 						Name:   "predefined-tag-name",
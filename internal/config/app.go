@@ -1,7 +1,18 @@
 package config
 
 import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
 	"project-helper/internal/domain/entity"
 )
 
@@ -11,28 +22,65 @@ type Application struct {
 	Path           string
 	DynamicFlags   DynamicFlags   `yaml:"dynamicFlags"`
 	PredefinedArgs PredefinedArgs `yaml:"predefinedArgs"`
+	Vars           Vars           `yaml:"vars,omitempty"`
+	// Applications share the settings above, each one layered over them.
+	Applications []Application `yaml:"applications,omitempty"`
 }
 
 type Operations []Operation
 
 type Operation struct {
-	Description       string
-	Name              string
-	ShortName         string `yaml:"shortName"`
-	Cmd               string
-	Args              []string
-	ExecutionPath     string             `yaml:"executionPath"`
-	ChangePath        bool               `yaml:"changePath"`
-	PredefinedArgsTag *PredefinedArgsTag `yaml:"predefinedArgsTag"`
-	RunBefore         Operations         `yaml:"runBefore"`
-	PredefinedFlags   PredefinedFlags    `yaml:"predefinedFlags"`
+	Description           string
+	Name                  string
+	ShortName             string `yaml:"shortName"`
+	Cmd                   string
+	Args                  []string
+	ExecutionPath         string              `yaml:"executionPath"`
+	ChangePath            bool                `yaml:"changePath"`
+	PredefinedArgsTag     *PredefinedArgsTag  `yaml:"predefinedArgsTag"`
+	PredefinedArgsTags    []PredefinedArgsTag `yaml:"predefinedArgsTags,omitempty"`
+	RunBefore             Operations          `yaml:"runBefore"`
+	PredefinedFlags       PredefinedFlags     `yaml:"predefinedFlags"`
+	AppendPassThroughArgs *bool               `yaml:"appendPassThroughArgs,omitempty"`
+	Vars                  Vars                `yaml:"vars,omitempty"`
+	AllowUnresolvedTags   bool                `yaml:"allowUnresolvedTags,omitempty"`
+	RawArgs               bool                `yaml:"rawArgs,omitempty"`
+}
+
+// ShouldAppendPassThroughArgs reports whether pass-through args are appended
+// to the args, which is not the case when an arg already uses them.
+func (o Operation) ShouldAppendPassThroughArgs(usesPassThroughArgs func(arg string) bool) bool {
+	if o.AppendPassThroughArgs != nil && !*o.AppendPassThroughArgs {
+		return false
+	}
+
+	return !slices.ContainsFunc(o.Args, usesPassThroughArgs)
+}
+
+// GetPredefinedArgsTags returns the single predefinedArgsTag binding followed
+// by the predefinedArgsTags list.
+func (o Operation) GetPredefinedArgsTags() []PredefinedArgsTag {
+	tags := make([]PredefinedArgsTag, 0, len(o.PredefinedArgsTags)+1)
+
+	if o.PredefinedArgsTag != nil {
+		tags = append(tags, *o.PredefinedArgsTag)
+	}
+
+	return append(tags, o.PredefinedArgsTags...)
 }
 
+const CompositeKeySeparator = "+"
+
 type PredefinedArgsTag struct {
 	Name  string
 	Value string
 }
 
+// GetFlagNames splits a composite binding such as env+region into its flags.
+func (t PredefinedArgsTag) GetFlagNames() []string {
+	return strings.Split(t.Name, CompositeKeySeparator)
+}
+
 type PredefinedFlags []PredefinedFlag
 
 type PredefinedFlag struct {
@@ -54,6 +102,42 @@ func (a *Application) GetOperationsMap() map[string]Operation {
 	return operationsMap
 }
 
+// ValidateOperationNames reports operations named like a ph command, which
+// would be shadowed by it.
+func (a *Application) ValidateOperationNames() error {
+	var errs Errors
+
+	for _, operation := range a.Operations {
+		for _, name := range []string{operation.Name, operation.ShortName} {
+			if slices.Contains(entity.Commands, name) {
+				errs.Add(fmt.Sprintf("operations[%s]", operation.Name), errors.Errorf("name %s is reserved for the ph %s command", name, name))
+			}
+		}
+	}
+
+	return errs.Err()
+}
+
+// ValidateFlagNames reports dynamic flags named like a flag of ph itself, which
+// can't be defined twice.
+func (a *Application) ValidateFlagNames() error {
+	var errs Errors
+
+	for _, dynamicFlag := range a.DynamicFlags {
+		path := fmt.Sprintf("dynamicFlags[%s]", dynamicFlag.Name)
+
+		if slices.Contains(entity.ReservedFlags, dynamicFlag.Name) {
+			errs.Add(path, errors.Errorf("name --%s is reserved", dynamicFlag.Name))
+		}
+
+		if dynamicFlag.ShortName == entity.OperationShortFlag {
+			errs.Add(path, errors.Errorf("shortName -%s is reserved for --%s", entity.OperationShortFlag, entity.OperationFlag))
+		}
+	}
+
+	return errs.Err()
+}
+
 func (a *Application) GetPredefinedArgs() map[string]PredefinedArg {
 	predefinedArgs := make(map[string]PredefinedArg)
 	for _, predefinedArg := range a.PredefinedArgs {
@@ -64,6 +148,66 @@ func (a *Application) GetPredefinedArgs() map[string]PredefinedArg {
 	return predefinedArgs
 }
 
+func (a *Application) GetVars(operation Operation) map[string]Var {
+	vars := make(map[string]Var, len(a.Vars)+len(operation.Vars))
+
+	for _, variable := range a.Vars {
+		vars[variable.Name] = variable
+	}
+
+	for _, variable := range operation.Vars {
+		vars[variable.Name] = variable
+	}
+
+	return vars
+}
+
+// TaggedValue is a config value that can hold tags, located by its path.
+type TaggedValue struct {
+	Path  string
+	Value string
+}
+
+func (a *Application) GetTaggedValues() []TaggedValue {
+	var values []TaggedValue
+
+	for _, operation := range a.Operations {
+		prefix := fmt.Sprintf("operations[%s]", operation.Name)
+
+		if !operation.RawArgs {
+			for i, arg := range operation.Args {
+				values = append(values, TaggedValue{Path: fmt.Sprintf("%s.args[%d]", prefix, i), Value: arg})
+			}
+		}
+
+		if operation.ExecutionPath != "" {
+			values = append(values, TaggedValue{Path: prefix + ".executionPath", Value: operation.ExecutionPath})
+		}
+
+		values = append(values, operation.Vars.GetTaggedValues(prefix+".vars")...)
+	}
+
+	values = append(values, a.Vars.GetTaggedValues("vars")...)
+
+	for _, predefinedArg := range a.PredefinedArgs {
+		prefix := fmt.Sprintf("predefinedArgs[%s]", predefinedArg.Name)
+
+		for _, arg := range predefinedArg.Args {
+			for i, value := range arg.Values {
+				values = append(values, TaggedValue{Path: fmt.Sprintf("%s.args[%s].values[%d]", prefix, arg.Name, i), Value: value})
+			}
+		}
+
+		if predefinedArg.Source != nil {
+			for i, value := range predefinedArg.Source.Values {
+				values = append(values, TaggedValue{Path: fmt.Sprintf("%s.source.values[%d]", prefix, i), Value: value})
+			}
+		}
+	}
+
+	return values
+}
+
 type DynamicFlags []DynamicFlag
 
 type DynamicFlag struct {
@@ -72,28 +216,278 @@ type DynamicFlag struct {
 	Description string
 	Type        entity.Type
 	Default     string
+	Sticky      bool
+	Separator   string
 }
 
 type PredefinedArgs []PredefinedArg
 
 type PredefinedArg struct {
-	Name string
-	Type entity.Type
-	Args Args
+	Name   string
+	Type   entity.Type
+	Args   Args
+	Source *ArgsSource `yaml:"source,omitempty"`
+}
+
+// ArgsSource loads args at runtime. Exactly one of File, Dir and Cmd is set.
+type ArgsSource struct {
+	File   string
+	Dir    string
+	Cmd    string
+	Values []string
+	TTL    time.Duration `yaml:"ttl,omitempty"`
+}
+
+// NewArg builds the arg of a key listed by the source. ${{match.0}} in Values
+// is replaced by the key; without Values the key itself is the value.
+func (s ArgsSource) NewArg(key string) Arg {
+	if len(s.Values) == 0 {
+		return Arg{Name: key, Values: []string{key}}
+	}
+
+	return Arg{Name: key, Values: Arg{Values: s.Values}.substituteMatch([]string{key})}
 }
 
 type Args []Arg
 
+// GetArgValues returns the values of the most specific arg matching name: an
+// exact key first, then the "re:" regex or glob with the most literal
+// characters, the first declared on a tie. ${{match.N}} in the values is
+// replaced by capture N.
 func (a Args) GetArgValues(name string) ([]string, error) {
+	arg, err := a.FindArg(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return arg.Values, nil
+}
+
+// FindArg returns the arg whose key matches the name, with pattern captures
+// substituted in its values.
+func (a Args) FindArg(name string) (Arg, error) {
 	for _, arg := range a {
 		if arg.Name == name {
-			return arg.Values, nil
+			return arg, nil
 		}
 	}
-	return nil, errors.Errorf("arg %s not found", name)
+
+	var (
+		best         *Arg
+		bestMatch    []string
+		bestSpecific = -1
+	)
+
+	for i := range a {
+		pattern, specificity, err := a[i].getPattern()
+		if err != nil {
+			return Arg{}, err
+		}
+
+		if pattern == nil || specificity <= bestSpecific {
+			continue
+		}
+
+		if match := pattern.FindStringSubmatch(name); match != nil {
+			best, bestMatch, bestSpecific = &a[i], match, specificity
+		}
+	}
+
+	if best == nil {
+		return Arg{}, errors.Errorf("arg %s not found", name)
+	}
+
+	return Arg{
+		Name:   best.Name,
+		Values: best.substituteMatch(bestMatch),
+	}, nil
 }
 
 type Arg struct {
 	Name   string
 	Values []string
 }
+
+// UnmarshalYAML also accepts values written as a map, as map predefinedArgs do,
+// and keeps them as key=value pairs in declaration order.
+func (a *Arg) UnmarshalYAML(unmarshal func(any) error) error {
+	type plain Arg
+
+	err := unmarshal((*plain)(a))
+	if err == nil {
+		return nil
+	}
+
+	var mapArg struct {
+		Name   string
+		Values yaml.MapSlice
+	}
+
+	if unmarshal(&mapArg) == nil {
+		a.Name = mapArg.Name
+		a.Values = make([]string, 0, len(mapArg.Values))
+
+		for _, item := range mapArg.Values {
+			a.Values = append(a.Values, fmt.Sprintf("%v=%v", item.Key, item.Value))
+		}
+
+		return nil
+	}
+
+	// yaml.v3 can't fill a yaml.MapSlice, so the map is read from its nodes.
+	var nodeArg struct {
+		Name   string
+		Values yamlv3.Node
+	}
+
+	if unmarshal(&nodeArg) != nil || nodeArg.Values.Kind != yamlv3.MappingNode {
+		return err
+	}
+
+	a.Name = nodeArg.Name
+	a.Values = make([]string, 0, len(nodeArg.Values.Content)/2)
+
+	for i := 0; i+1 < len(nodeArg.Values.Content); i += 2 {
+		key, value := nodeArg.Values.Content[i], nodeArg.Values.Content[i+1]
+		if value.Kind != yamlv3.ScalarNode {
+			return err
+		}
+
+		a.Values = append(a.Values, fmt.Sprintf("%v=%v", key.Value, value.Value))
+	}
+
+	return nil
+}
+
+const (
+	RegexArgPrefix = "re:"
+	CatchAllArg    = "*"
+)
+
+type argPattern struct {
+	pattern  *regexp.Regexp
+	literals int
+	err      error
+}
+
+// argPatterns caches the compiled pattern of each arg key, args are matched on every lookup.
+var argPatterns sync.Map
+
+// getPattern also returns the number of literal characters in the pattern, so
+// that the most specific regex or glob wins.
+func (a Arg) getPattern() (*regexp.Regexp, int, error) {
+	if cached, ok := argPatterns.Load(a.Name); ok {
+		compiled := cached.(argPattern)
+
+		return compiled.pattern, compiled.literals, compiled.err
+	}
+
+	pattern, literals, err := a.compilePattern()
+	argPatterns.Store(a.Name, argPattern{pattern: pattern, literals: literals, err: err})
+
+	return pattern, literals, err
+}
+
+func (a Arg) compilePattern() (*regexp.Regexp, int, error) {
+	if expression, ok := strings.CutPrefix(a.Name, RegexArgPrefix); ok {
+		pattern, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "arg %s has an invalid regex", a.Name)
+		}
+
+		parsed, err := syntax.Parse(expression, syntax.Perl)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "arg %s has an invalid regex", a.Name)
+		}
+
+		return pattern, countLiterals(parsed), nil
+	}
+
+	if a.Name == CatchAllArg || !strings.ContainsAny(a.Name, "*?") {
+		return nil, 0, nil
+	}
+
+	var (
+		expression strings.Builder
+		literals   int
+	)
+
+	expression.WriteString("^")
+
+	for _, char := range a.Name {
+		switch char {
+		case '*':
+			expression.WriteString("(.*)")
+		case '?':
+			expression.WriteString("(.)")
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(char)))
+			literals++
+		}
+	}
+
+	expression.WriteString("$")
+
+	return regexp.MustCompile(expression.String()), literals, nil
+}
+
+func countLiterals(expression *syntax.Regexp) int {
+	if expression.Op == syntax.OpLiteral {
+		return len(expression.Rune)
+	}
+
+	literals := 0
+	for _, sub := range expression.Sub {
+		literals += countLiterals(sub)
+	}
+
+	return literals
+}
+
+func (a Arg) substituteMatch(match []string) []string {
+	pairs := make([]string, 0, len(match)*2)
+	for i, group := range match {
+		pairs = append(pairs, entity.TagPrefix+entity.MatchTagNamespace+"."+strconv.Itoa(i)+"}}", group)
+	}
+
+	replacer := strings.NewReplacer(pairs...)
+
+	values := make([]string, 0, len(a.Values))
+	for _, value := range a.Values {
+		values = append(values, replacer.Replace(value))
+	}
+
+	return values
+}
+
+type Vars []Var
+
+func (v Vars) GetTaggedValues(prefix string) []TaggedValue {
+	var values []TaggedValue
+
+	for _, variable := range v {
+		field := "value"
+		if variable.Cmd != "" {
+			field = "cmd"
+		}
+
+		values = append(values, TaggedValue{Path: fmt.Sprintf("%s[%s].%s", prefix, variable.Name, field), Value: variable.GetExpression()})
+	}
+
+	return values
+}
+
+type Var struct {
+	Name  string
+	Value string
+	Cmd   string
+	Cache entity.Cache
+}
+
+func (v Var) GetExpression() string {
+	if v.Cmd != "" {
+		return v.Cmd
+	}
+
+	return v.Value
+}
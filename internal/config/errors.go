@@ -0,0 +1,54 @@
+package config
+
+import (
+	"strings"
+)
+
+// Error is a problem of a config entry, located by its path such as
+// predefinedArgs[env].type.
+type Error struct {
+	Path string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Errors collects every problem found by a validator, so they are reported
+// at once instead of one per run.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Add records err at path. Errors of nested validators keep their own paths.
+func (e *Errors) Add(path string, err error) {
+	if nested, ok := err.(Errors); ok {
+		*e = append(*e, nested...)
+
+		return
+	}
+
+	*e = append(*e, &Error{Path: path, Err: err})
+}
+
+// Err returns nil when no problem was found.
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
@@ -0,0 +1,13 @@
+package config
+
+const (
+	GlobalScope  = "global"
+	ProjectScope = "project"
+)
+
+// File is a config file loaded by ph. Project files are layered over the
+// global one in load order.
+type File struct {
+	Path  string
+	Scope string
+}
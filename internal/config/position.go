@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Position locates a value in a config file. Paths use the names of list
+// entries, for example operations[deploy].runBefore[build].
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	switch {
+	case p.Line == 0:
+		return p.File
+	case p.Column == 0:
+		return fmt.Sprintf("%s:%d", p.File, p.Line)
+	default:
+		return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+	}
+}
+
+type Positions map[string]Position
+
+// Get returns the position of the path or of its closest known parent.
+func (p Positions) Get(path string) (Position, bool) {
+	for path != "" {
+		if position, ok := p[path]; ok {
+			return position, true
+		}
+
+		index := strings.LastIndexAny(path, ".[")
+		if index == -1 {
+			break
+		}
+
+		path = path[:index]
+	}
+
+	return Position{}, false
+}
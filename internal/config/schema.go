@@ -0,0 +1,148 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"project-helper/internal/domain/entity"
+)
+
+const schemaVersion = "http://json-schema.org/draft-07/schema#"
+
+var (
+	schemaEnums = map[reflect.Type][]string{
+		reflect.TypeOf(entity.Type("")):  {string(entity.String), string(entity.Array), string(entity.Map)},
+		reflect.TypeOf(entity.Cache("")): {string(entity.RunCache), string(entity.SessionCache)},
+	}
+
+	// schemaOverrides describe fields accepting more than their Go type, such
+	// as map values of map predefinedArgs.
+	schemaOverrides = map[string]map[string]any{
+		"Arg.Values": {
+			"anyOf": []any{
+				map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+				map[string]any{"type": "object", "additionalProperties": map[string]any{"type": []string{"string", "number", "boolean"}}},
+			},
+		},
+	}
+)
+
+// Schema returns the JSON Schema of the application config, generated from
+// the config structs so editors can complete and validate config files.
+func Schema() map[string]any {
+	definitions := make(map[string]any)
+
+	schema := map[string]any{
+		"$schema":     schemaVersion,
+		"title":       "project-helper application config",
+		"definitions": definitions,
+	}
+
+	for key, value := range getStructSchema(reflect.TypeOf(Application{}), definitions) {
+		schema[key] = value
+	}
+
+	return schema
+}
+
+// MarshalSchema renders the schema as indented JSON.
+func MarshalSchema() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(Schema()); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func getSchema(t reflect.Type, definitions map[string]any) map[string]any {
+	if enum, ok := schemaEnums[t]; ok {
+		return map[string]any{"type": "string", "enum": enum}
+	}
+
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return map[string]any{"type": "string", "pattern": `^(\d+(\.\d+)?(ns|us|µs|ms|s|m|h))+$`}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := getSchema(t.Elem(), definitions)
+
+		return map[string]any{"anyOf": []any{schema, map[string]any{"type": "null"}}}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": getSchema(t.Elem(), definitions)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": getSchema(t.Elem(), definitions)}
+	case reflect.Struct:
+		if _, ok := definitions[t.Name()]; !ok {
+			// Reserve the name first, so recursive types such as Operation
+			// reference their own definition.
+			definitions[t.Name()] = nil
+			definitions[t.Name()] = getStructSchema(t, definitions)
+		}
+
+		return map[string]any{"$ref": "#/definitions/" + t.Name()}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
+
+func getStructSchema(t reflect.Type, definitions map[string]any) map[string]any {
+	properties := make(map[string]any, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := getYAMLName(field)
+		if name == "" {
+			continue
+		}
+
+		if override, ok := schemaOverrides[t.Name()+"."+field.Name]; ok {
+			properties[name] = override
+
+			continue
+		}
+
+		properties[name] = getSchema(field.Type, definitions)
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// getYAMLName follows the yaml.v3 decoder: the tag name when set, the
+// lowercased field name otherwise, and nothing for ignored fields.
+func getYAMLName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return strings.ToLower(field.Name)
+	default:
+		return name
+	}
+}
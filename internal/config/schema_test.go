@@ -0,0 +1,31 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schemaPath = "../../config/application.schema.json"
+
+var update = flag.Bool("update", false, "update the committed config schema")
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	schema, err := MarshalSchema()
+	require.NoError(t, err)
+
+	if *update {
+		require.NoError(t, os.WriteFile(schemaPath, schema, 0o644))
+	}
+
+	expected, err := os.ReadFile(schemaPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expected), string(schema),
+		"config structs changed, update the schema with: go test ./internal/config -run TestSchema -update")
+}
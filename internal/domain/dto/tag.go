@@ -9,4 +9,17 @@ type GetTagValueRequest struct {
 	Operation    config.Operation `validate:"required"`
 	Flags        *entity.Flags    `validate:"required"`
 	ExtractedTag string           `validate:"required"`
+	// ResolveTags resolves the tags nested in a value read by a provider, such
+	// as a var or an environment variable.
+	ResolveTags func(value string) (string, error)
+}
+
+// Resolve returns value with its nested tags resolved, or as it is when the
+// request can't resolve tags.
+func (r *GetTagValueRequest) Resolve(value string) (string, error) {
+	if r.ResolveTags == nil {
+		return value, nil
+	}
+
+	return r.ResolveTags(value)
 }
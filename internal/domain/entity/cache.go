@@ -0,0 +1,8 @@
+package entity
+
+type Cache string
+
+const (
+	RunCache     Cache = "run"
+	SessionCache Cache = "session"
+)
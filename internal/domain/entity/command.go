@@ -0,0 +1,12 @@
+package entity
+
+const (
+	FlagsCommand  = "flags"
+	ArgsCommand   = "args"
+	ConfigCommand = "config"
+	AppsCommand   = "apps"
+)
+
+// Commands are dispatched before operations, so operations can't use their
+// names.
+var Commands = []string{FlagsCommand, ArgsCommand, ConfigCommand, AppsCommand}
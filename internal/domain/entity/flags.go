@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -10,10 +12,25 @@ import (
 var errOperationNotProvided = errors.New("operation not provided")
 var errInvalidFlagTypeValue = errors.New("invalid flag type value")
 
+const DefaultSeparator = ","
+
+const (
+	OperationFlag      = "operation"
+	OperationShortFlag = "o"
+	ParallelFlag       = "parallel"
+	NoInputFlag        = "no-input"
+	KeepTmpFlag        = "keep-tmp"
+	ApplicationFlag    = "app"
+)
+
+// ReservedFlags are defined by ph itself and can't be used by dynamic flags.
+var ReservedFlags = []string{OperationFlag, ParallelFlag, NoInputFlag, KeepTmpFlag, ApplicationFlag}
+
 type DynamicFlagValue struct {
-	Name  string
-	Type  Type
-	Value any
+	Name      string
+	Type      Type
+	Value     any
+	Separator string
 }
 
 func GetString(d *DynamicFlagValue) (string, error) {
@@ -43,15 +60,110 @@ func GetString(d *DynamicFlagValue) (string, error) {
 			return "", errors.Wrap(errInvalidFlagTypeValue, "flag is empty")
 		}
 
-		return strings.Join(*value, ","), nil
+		return strings.Join(*value, getSeparator(d)), nil
+	case Map:
+		value, ok := d.Value.(*map[string]string)
+		if !ok {
+			return "", errors.Wrap(errInvalidFlagTypeValue, "flag is not a map")
+		}
+
+		if value == nil {
+			return "", errors.Wrap(errInvalidFlagTypeValue, "flag is nil")
+		}
+
+		if len(*value) == 0 {
+			return "", errors.Wrap(errInvalidFlagTypeValue, "flag is empty")
+		}
+
+		return JoinMap(*value, getSeparator(d)), nil
 	default:
 		return "", errors.Wrapf(errInvalidFlagTypeValue, "flag type '%s' is not supported", d.Type)
 	}
 }
 
+func GetValues(d *DynamicFlagValue) ([]string, error) {
+	if d == nil {
+		return nil, domainerrors.ErrorNilInput
+	}
+
+	switch d.Type {
+	case Array:
+		value, ok := d.Value.(*[]string)
+		if !ok || value == nil {
+			return nil, errors.Wrap(errInvalidFlagTypeValue, "flag is not an array")
+		}
+
+		return slices.Clone(*value), nil
+	case Map:
+		value, ok := d.Value.(*map[string]string)
+		if !ok || value == nil {
+			return nil, errors.Wrap(errInvalidFlagTypeValue, "flag is not a map")
+		}
+
+		return GetMapPairs(*value), nil
+	default:
+		value, err := GetString(d)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{value}, nil
+	}
+}
+
+func JoinMap(value map[string]string, separator string) string {
+	return strings.Join(GetMapPairs(value), separator)
+}
+
+func GetMapPairs(value map[string]string) []string {
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + value[key]
+	}
+
+	return pairs
+}
+
+func getSeparator(d *DynamicFlagValue) string {
+	if d.Separator == "" {
+		return DefaultSeparator
+	}
+
+	return d.Separator
+}
+
+func IsEmpty(d *DynamicFlagValue) bool {
+	if d == nil {
+		return true
+	}
+
+	switch value := d.Value.(type) {
+	case *string:
+		return value == nil || *value == ""
+	case *[]string:
+		return value == nil || len(*value) == 0
+	case *map[string]string:
+		return value == nil || len(*value) == 0
+	default:
+		return d.Value == nil
+	}
+}
+
 type Flags struct {
-	Operation    string
-	DynamicFlags map[string]*DynamicFlagValue
+	Operations      []string
+	Parallel        bool
+	NoInput         bool
+	KeepTmp         bool
+	Application     string
+	PassThroughArgs []string
+	DynamicFlags    map[string]*DynamicFlagValue
 }
 
 func NewFlags() *Flags {
@@ -61,10 +173,16 @@ func NewFlags() *Flags {
 }
 
 func (f *Flags) Validate() error {
-	if f.Operation == "" {
+	if len(f.Operations) == 0 {
 		return errOperationNotProvided
 	}
 
+	for _, operation := range f.Operations {
+		if operation == "" {
+			return errOperationNotProvided
+		}
+	}
+
 	return nil
 }
 
@@ -136,3 +254,39 @@ func (f *Flags) GetFlagArrayValue(flag string) []string {
 
 	return value
 }
+
+func (f *Flags) GetRequiredFlagMapValue(flag string) (map[string]string, error) {
+	dynamicFlagValue, ok := f.DynamicFlags[flag]
+
+	if !ok {
+		return nil, errors.Errorf("flag %s not found", flag)
+	}
+	if dynamicFlagValue == nil {
+		return nil, errors.Errorf("flag %s is nil", flag)
+	}
+
+	assertedValue, ok := dynamicFlagValue.Value.(*map[string]string)
+	if !ok {
+		return nil, errors.Errorf("flag %s is not a map", flag)
+	}
+
+	if assertedValue == nil {
+		return nil, errors.Errorf("flag %s is nil", flag)
+	}
+
+	return *assertedValue, nil
+}
+
+func (f *Flags) GetRequiredFlagMapKeyValue(flag string, key string) (string, error) {
+	value, err := f.GetRequiredFlagMapValue(flag)
+	if err != nil {
+		return "", err
+	}
+
+	keyValue, ok := value[key]
+	if !ok {
+		return "", errors.Errorf("flag %s has no key %s", flag, key)
+	}
+
+	return keyValue, nil
+}
@@ -66,6 +66,48 @@ func TestDynamicFlagValueGetString(t *testing.T) {
 			},
 			expectedValue: "value1,value2",
 		},
+		"success map": {
+			dynamicFlagValue: &DynamicFlagValue{
+				Name:  "flag",
+				Type:  Map,
+				Value: &map[string]string{"tier": "2", "team": "core"},
+			},
+			expectedValue: "team=core,tier=2",
+		},
+		"success array with separator": {
+			dynamicFlagValue: &DynamicFlagValue{
+				Name:      "flag",
+				Type:      Array,
+				Value:     &[]string{"api", "web"},
+				Separator: " ",
+			},
+			expectedValue: "api web",
+		},
+		"success map with separator": {
+			dynamicFlagValue: &DynamicFlagValue{
+				Name:      "flag",
+				Type:      Map,
+				Value:     &map[string]string{"tier": "2", "team": "core"},
+				Separator: " ",
+			},
+			expectedValue: "team=core tier=2",
+		},
+		"not a map": {
+			dynamicFlagValue: &DynamicFlagValue{
+				Name:  "flag",
+				Type:  Map,
+				Value: utils.MakePointer("value"),
+			},
+			expectedError: errors.New("flag is not a map: invalid flag type value"),
+		},
+		"map empty": {
+			dynamicFlagValue: &DynamicFlagValue{
+				Name:  "flag",
+				Type:  Map,
+				Value: &map[string]string{},
+			},
+			expectedError: errors.New("flag is empty: invalid flag type value"),
+		},
 		"nil": {
 			dynamicFlagValue: nil,
 			expectedError:    errors.New("nil input"),
@@ -129,6 +171,56 @@ func TestDynamicFlagValueGetString(t *testing.T) {
 	}
 }
 
+func TestDynamicFlagValueGetValues(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dynamicFlagValue *DynamicFlagValue
+		expectedValues   []string
+		expectedErr      error
+	}{
+		"string": {
+			dynamicFlagValue: &DynamicFlagValue{Name: "flag", Type: String, Value: utils.MakePointer("value")},
+			expectedValues:   []string{"value"},
+		},
+		"array": {
+			dynamicFlagValue: &DynamicFlagValue{Name: "flag", Type: Array, Value: &[]string{"api", "web"}},
+			expectedValues:   []string{"api", "web"},
+		},
+		"empty array": {
+			dynamicFlagValue: &DynamicFlagValue{Name: "flag", Type: Array, Value: &[]string{}},
+			expectedValues:   []string{},
+		},
+		"map": {
+			dynamicFlagValue: &DynamicFlagValue{Name: "flag", Type: Map, Value: &map[string]string{"tier": "2", "team": "core"}},
+			expectedValues:   []string{"team=core", "tier=2"},
+		},
+		"not an array": {
+			dynamicFlagValue: &DynamicFlagValue{Name: "flag", Type: Array, Value: utils.MakePointer("value")},
+			expectedErr:      errors.New("flag is not an array"),
+		},
+		"nil": {
+			expectedErr: errors.New("nil input"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := GetValues(testCase.dynamicFlagValue)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedValues, values)
+			}
+		})
+	}
+}
+
 func TestNewFlags(t *testing.T) {
 	t.Parallel()
 
@@ -136,7 +228,7 @@ func TestNewFlags(t *testing.T) {
 
 	assert.NotNil(t, flags)
 	assert.Empty(t, flags.DynamicFlags)
-	assert.Empty(t, flags.Operation)
+	assert.Empty(t, flags.Operations)
 }
 
 func TestFlagsValidate(t *testing.T) {
@@ -148,13 +240,19 @@ func TestFlagsValidate(t *testing.T) {
 	}{
 		"success": {
 			flags: &Flags{
-				Operation: "operation",
+				Operations: []string{"operation"},
 			},
 		},
 		"error operation not provided": {
 			flags:         &Flags{},
 			expectedError: errors.New("operation not provided"),
 		},
+		"error empty operation": {
+			flags: &Flags{
+				Operations: []string{"operation", ""},
+			},
+			expectedError: errors.New("operation not provided"),
+		},
 	}
 
 	for name, testCase := range tests {
@@ -369,3 +467,105 @@ func TestGetFlagArrayValue(t *testing.T) {
 		})
 	}
 }
+
+func TestIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dynamicFlagValue *DynamicFlagValue
+		expected         bool
+	}{
+		"nil": {
+			expected: true,
+		},
+		"empty string": {
+			dynamicFlagValue: &DynamicFlagValue{Type: String, Value: utils.MakePointer("")},
+			expected:         true,
+		},
+		"string": {
+			dynamicFlagValue: &DynamicFlagValue{Type: String, Value: utils.MakePointer("value")},
+		},
+		"empty array": {
+			dynamicFlagValue: &DynamicFlagValue{Type: Array, Value: &[]string{}},
+			expected:         true,
+		},
+		"array": {
+			dynamicFlagValue: &DynamicFlagValue{Type: Array, Value: &[]string{"value"}},
+		},
+		"nil value": {
+			dynamicFlagValue: &DynamicFlagValue{Type: String},
+			expected:         true,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, IsEmpty(testCase.dynamicFlagValue))
+		})
+	}
+}
+
+func TestGetRequiredFlagMapKeyValue(t *testing.T) {
+	t.Parallel()
+
+	flags := &Flags{
+		DynamicFlags: map[string]*DynamicFlagValue{
+			"label": {
+				Name:  "label",
+				Type:  Map,
+				Value: &map[string]string{"team": "core"},
+			},
+			"flag": {
+				Name:  "flag",
+				Type:  String,
+				Value: utils.MakePointer("value"),
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		flag          string
+		key           string
+		expectedValue string
+		expectedError error
+	}{
+		"with value": {
+			flag:          "label",
+			key:           "team",
+			expectedValue: "core",
+		},
+		"without key": {
+			flag:          "label",
+			key:           "tier",
+			expectedError: errors.New("flag label has no key tier"),
+		},
+		"without flag": {
+			flag:          "unknown",
+			key:           "team",
+			expectedError: errors.New("flag unknown not found"),
+		},
+		"not a map": {
+			flag:          "flag",
+			key:           "team",
+			expectedError: errors.New("flag flag is not a map"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := flags.GetRequiredFlagMapKeyValue(testCase.flag, testCase.key)
+
+			if testCase.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expectedValue, value)
+			}
+		})
+	}
+}
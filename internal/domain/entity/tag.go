@@ -1,10 +1,106 @@
 package entity
 
+import (
+	"slices"
+	"strings"
+)
+
 type Tag string
 
 type Tags []Tag
 
+type TagExpression struct {
+	Name    string
+	Splat   bool
+	Filters TagFilters
+}
+
+type TagFilters []TagFilter
+
+type TagFilter struct {
+	Name string
+	Args []string
+}
+
+const (
+	DefaultTagFilter = "default"
+	JoinTagFilter    = "join"
+)
+
+func (f TagFilters) GetDefault() (string, bool) {
+	for _, filter := range f {
+		if filter.Name == DefaultTagFilter && len(filter.Args) == 1 {
+			return filter.Args[0], true
+		}
+	}
+
+	return "", false
+}
+
+func (f TagFilters) Contains(name string) bool {
+	return slices.ContainsFunc(f, func(filter TagFilter) bool {
+		return filter.Name == name
+	})
+}
+
 const (
 	ApplicationPathTag = "application-path"
 	ExecutionPathTag   = "execution-path"
+	PassThroughArgsTag = "args"
+
+	GitBranchTag   = "git-branch"
+	GitShaTag      = "git-sha"
+	GitShortShaTag = "git-short-sha"
+	GitRootTag     = "git-root"
+	GitDirtyTag    = "git-dirty"
+	TimestampTag   = "timestamp"
+	DateTag        = "date"
+	UserTag        = "user"
+	HostnameTag    = "hostname"
+	OsTag          = "os"
+	ArchTag        = "arch"
+	CwdTag         = "cwd"
+	OperationTag   = "operation"
+	RunIdTag       = "run-id"
+	FreePortTag    = "free-port"
+	TmpDirTag      = "tmpdir"
 )
+
+// BuiltinTags are resolved without any configuration.
+var BuiltinTags = []string{
+	ApplicationPathTag, ExecutionPathTag, PassThroughArgsTag, GitBranchTag, GitShaTag, GitShortShaTag, GitRootTag,
+	GitDirtyTag, TimestampTag, DateTag, UserTag, HostnameTag, OsTag, ArchTag, CwdTag, OperationTag, RunIdTag,
+	FreePortTag, TmpDirTag,
+}
+
+const (
+	TagPrefix        = "${{"
+	TagEscape        = "$"
+	EscapedTagPrefix = TagEscape + TagPrefix
+	MaxTagDepth      = 10
+)
+
+const (
+	FlagTagNamespace   = "flag"
+	EnvTagNamespace    = "env"
+	GitTagNamespace    = "git"
+	VarTagNamespace    = "var"
+	SecretTagNamespace = "secret"
+	FileTagNamespace   = "file"
+	JSONTagNamespace   = "json"
+	YAMLTagNamespace   = "yaml"
+	MatchTagNamespace  = "match"
+)
+
+const PassThroughArgsTagValue = TagPrefix + PassThroughArgsTag + "}}"
+
+// escaped tags are swapped for a byte that cannot appear in argv while the real tags are replaced
+const escapedTagPlaceholder = "\x00"
+
+func ProtectEscapedTags(value string) string {
+	return strings.ReplaceAll(value, EscapedTagPrefix, escapedTagPlaceholder)
+}
+
+func RestoreEscapedTags(value string) string {
+	return strings.ReplaceAll(value, escapedTagPlaceholder, TagPrefix)
+}
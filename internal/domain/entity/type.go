@@ -5,4 +5,5 @@ type Type string
 const (
 	String Type = "string"
 	Array  Type = "array"
+	Map    Type = "map"
 )
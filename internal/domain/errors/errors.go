@@ -3,11 +3,18 @@ package errors
 import "errors"
 
 var (
-	ErrorOperationNotFound      = errors.New("operation not found")
-	ErrorTagValueNotFound       = errors.New("tag value not found")
-	ErrorPredefinedArgNotFound  = errors.New("predefined arg not found")
-	ErrorPatternTagTypeNotFound = errors.New("pattern tag type not found")
-	ErrorNilInput               = errors.New("nil input")
-	ErrorAdditionalArgNotFound  = errors.New("additional arg not found")
-	ErrorObjectIsNil            = errors.New("object is nil")
+	ErrorOperationNotFound         = errors.New("operation not found")
+	ErrorTagValueNotFound          = errors.New("tag value not found")
+	ErrorPredefinedArgNotFound     = errors.New("predefined arg not found")
+	ErrorPatternTagTypeNotFound    = errors.New("pattern tag type not found")
+	ErrorNilInput                  = errors.New("nil input")
+	ErrorObjectIsNil               = errors.New("object is nil")
+	ErrorEnvVariableNotSet         = errors.New("environment variable not set")
+	ErrorTagCycle                  = errors.New("tag cycle")
+	ErrorTagDepthExceeded          = errors.New("tag depth exceeded")
+	ErrorUnresolvedTag             = errors.New("unresolved tag")
+	ErrorSecretNotFound            = errors.New("secret not found")
+	ErrorPredefinedArgTypeMismatch = errors.New("predefined arg type mismatch")
+	ErrorApplicationNotFound       = errors.New("application not found")
+	ErrorApplicationNotSelected    = errors.New("application not selected")
 )
@@ -68,6 +68,88 @@ func (mr *MockExtractorServiceMockRecorder) ExtractTags(arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTags", reflect.TypeOf((*MockExtractorService)(nil).ExtractTags), arg)
 }
 
+// ParseTag mocks base method.
+func (m *MockExtractorService) ParseTag(tag entity.Tag) (*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTag", tag)
+	ret0, _ := ret[0].(*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTag indicates an expected call of ParseTag.
+func (mr *MockExtractorServiceMockRecorder) ParseTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTag", reflect.TypeOf((*MockExtractorService)(nil).ParseTag), tag)
+}
+
+// MockFilterService is a mock of FilterService interface.
+type MockFilterService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilterServiceMockRecorder
+}
+
+// MockFilterServiceMockRecorder is the mock recorder for MockFilterService.
+type MockFilterServiceMockRecorder struct {
+	mock *MockFilterService
+}
+
+// NewMockFilterService creates a new mock instance.
+func NewMockFilterService(ctrl *gomock.Controller) *MockFilterService {
+	mock := &MockFilterService{ctrl: ctrl}
+	mock.recorder = &MockFilterServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFilterService) EXPECT() *MockFilterServiceMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockFilterService) Apply(value string, filters entity.TagFilters) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", value, filters)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockFilterServiceMockRecorder) Apply(value, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockFilterService)(nil).Apply), value, filters)
+}
+
+// ApplyValues mocks base method.
+func (m *MockFilterService) ApplyValues(values []string, filters entity.TagFilters) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyValues", values, filters)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyValues indicates an expected call of ApplyValues.
+func (mr *MockFilterServiceMockRecorder) ApplyValues(values, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyValues", reflect.TypeOf((*MockFilterService)(nil).ApplyValues), values, filters)
+}
+
+// Validate mocks base method.
+func (m *MockFilterService) Validate(filters entity.TagFilters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", filters)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockFilterServiceMockRecorder) Validate(filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockFilterService)(nil).Validate), filters)
+}
+
 // MockTagService is a mock of TagService interface.
 type MockTagService struct {
 	ctrl     *gomock.Controller
@@ -106,6 +188,21 @@ func (mr *MockTagServiceMockRecorder) GetTagValue(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagValue", reflect.TypeOf((*MockTagService)(nil).GetTagValue), request)
 }
 
+// GetTagValues mocks base method.
+func (m *MockTagService) GetTagValues(request *dto.GetTagValueRequest) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagValues", request)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagValues indicates an expected call of GetTagValues.
+func (mr *MockTagServiceMockRecorder) GetTagValues(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagValues", reflect.TypeOf((*MockTagService)(nil).GetTagValues), request)
+}
+
 // MockPredefinedArgService is a mock of PredefinedArgService interface.
 type MockPredefinedArgService struct {
 	ctrl     *gomock.Controller
@@ -158,3 +255,18 @@ func (mr *MockPredefinedArgServiceMockRecorder) TryToFindPredefinedArgValue(requ
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryToFindPredefinedArgValue", reflect.TypeOf((*MockPredefinedArgService)(nil).TryToFindPredefinedArgValue), request)
 }
+
+// TryToFindPredefinedArgValues mocks base method.
+func (m *MockPredefinedArgService) TryToFindPredefinedArgValues(request *dto.TryToFindPredefinedArgRequest) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryToFindPredefinedArgValues", request)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TryToFindPredefinedArgValues indicates an expected call of TryToFindPredefinedArgValues.
+func (mr *MockPredefinedArgServiceMockRecorder) TryToFindPredefinedArgValues(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryToFindPredefinedArgValues", reflect.TypeOf((*MockPredefinedArgService)(nil).TryToFindPredefinedArgValues), request)
+}
@@ -3,11 +3,14 @@ package enhance
 //go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
+	"project-helper/internal/config"
 	"project-helper/internal/domain/dto"
 	"project-helper/internal/domain/entity"
+	domainerrors "project-helper/internal/domain/errors"
 	"project-helper/internal/utils"
 )
 
@@ -15,12 +18,20 @@ type (
 	ExtractorService interface {
 		ExtractTags(arg entity.Arg) entity.Tags
 		ExtractTag(tag entity.Tag) (string, error)
+		ParseTag(tag entity.Tag) (*entity.TagExpression, error)
+	}
+	FilterService interface {
+		Validate(filters entity.TagFilters) error
+		Apply(value string, filters entity.TagFilters) (string, error)
+		ApplyValues(values []string, filters entity.TagFilters) (string, error)
 	}
 	TagService interface {
 		GetTagValue(request *dto.GetTagValueRequest) (string, error)
+		GetTagValues(request *dto.GetTagValueRequest) ([]string, error)
 	}
 	PredefinedArgService interface {
 		TryToFindPredefinedArgValue(request *dto.TryToFindPredefinedArgRequest) (string, error)
+		TryToFindPredefinedArgValues(request *dto.TryToFindPredefinedArgRequest) ([]string, error)
 		GetPredefinedArgValues(request *dto.GetPredefinedArgsRequest) ([]string, error)
 	}
 )
@@ -29,17 +40,30 @@ type Service struct {
 	extractorService     ExtractorService
 	tagService           TagService
 	predefinedArgService PredefinedArgService
+	filterService        FilterService
 }
 
 func NewService(
 	extractorService ExtractorService,
 	tagService TagService,
 	predefinedArgService PredefinedArgService,
+	filterService FilterService,
 ) *Service {
 	return &Service{
 		extractorService:     extractorService,
 		tagService:           tagService,
 		predefinedArgService: predefinedArgService,
+		filterService:        filterService,
+	}
+}
+
+// NewPathService returns a service resolving the env tags of config paths. The
+// paths are resolved while the config loads, before predefined args are known.
+func NewPathService(extractorService ExtractorService, tagService TagService, filterService FilterService) *Service {
+	return &Service{
+		extractorService: extractorService,
+		tagService:       tagService,
+		filterService:    filterService,
 	}
 }
 
@@ -49,51 +73,390 @@ func (s *Service) EnhanceArgs(request *dto.EnhanceArgsRequest) ([]string, error)
 		return make([]string, 0), errors.Wrap(err, "request is not valid")
 	}
 
-	args := make([]string, len(request.Args))
+	args := make([]string, 0, len(request.Args))
+
+	for _, arg := range request.Args {
+		arg = entity.ProtectEscapedTags(arg)
 
-	for i, arg := range request.Args {
 		enhanceTags := s.extractorService.ExtractTags(entity.Arg(arg))
 
 		if len(enhanceTags) == 0 {
-			args[i] = arg
+			if err = checkUnresolvedTags(request.Operation, arg); err != nil {
+				return nil, err
+			}
+
+			args = append(args, entity.RestoreEscapedTags(arg))
 			continue
 		}
 
-		for _, enhanceTag := range enhanceTags {
-			extractedEnhanceTag, err := s.extractorService.ExtractTag(enhanceTag)
+		enhancedArgs, err := s.enhanceArg(request, arg, enhanceTags)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, enhancedArg := range enhancedArgs {
+			escapeArg, err := utils.EscapeValue(entity.RestoreEscapedTags(enhancedArg))
 			if err != nil {
-				return nil, errors.Wrapf(err, "failed to extract tag")
+				return nil, errors.Wrap(err, "failed to escape arg")
 			}
 
-			tagValue, err := s.tagService.GetTagValue(&dto.GetTagValueRequest{
-				Operation:    request.Operation,
-				Flags:        request.Flags,
-				ExtractedTag: extractedEnhanceTag,
-			})
+			args = append(args, escapeArg)
+		}
+	}
+
+	return args, nil
+}
+
+// ResolvePath resolves the env tags of a config path. Other tags are rejected,
+// as flags and vars aren't known while the config loads.
+func (s *Service) ResolvePath(path string) (string, error) {
+	path = entity.ProtectEscapedTags(path)
+
+	tags := s.extractorService.ExtractTags(entity.Arg(path))
+
+	for _, tag := range tags {
+		tagExpression, err := s.extractorService.ParseTag(tag)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse tag")
+		}
+
+		if namespace, _, ok := strings.Cut(tagExpression.Name, "."); !ok || namespace != entity.EnvTagNamespace {
+			return "", errors.Errorf("tag %s is not supported in path, only env tags are allowed", tag)
+		}
+	}
+
+	// a path belongs to no operation, the request only names it for providers
+	request := &dto.EnhanceArgsRequest{Operation: config.Operation{Name: "path"}, Flags: entity.NewFlags()}
+
+	path, err := s.replaceTags(request, path, tags, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return entity.RestoreEscapedTags(path), nil
+}
+
+func (s *Service) enhanceArg(request *dto.EnhanceArgsRequest, arg string, tags entity.Tags) ([]string, error) {
+	var (
+		enhancedArg = arg
+		splatTags   entity.Tags
+		splatValues [][]string
+	)
+
+	for _, enhanceTag := range tags {
+		tagExpression, err := s.extractorService.ParseTag(enhanceTag)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to extract tag")
+		}
+
+		if !tagExpression.Splat {
+			tagValue, err := s.resolveTag(request, tagExpression, nil)
 			if err != nil {
-				return nil, errors.Wrapf(err, "failed to get tag value")
+				return nil, err
 			}
 
-			tagValue, err = s.predefinedArgService.TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
-				ParsedTag: extractedEnhanceTag,
-				Value:     tagValue,
-			})
+			enhancedArg = strings.ReplaceAll(enhancedArg, string(enhanceTag), tagValue)
+
+			continue
+		}
+
+		if slices.Contains(splatTags, enhanceTag) {
+			continue
+		}
+
+		tagValues, err := s.resolveTagValues(request, tagExpression)
+		if err != nil {
+			return nil, err
+		}
+
+		splatTags = append(splatTags, enhanceTag)
+		splatValues = append(splatValues, tagValues)
+	}
+
+	template := enhancedArg
+	for _, splatTag := range splatTags {
+		template = strings.ReplaceAll(template, string(splatTag), "")
+	}
+
+	if err := checkUnresolvedTags(request.Operation, template); err != nil {
+		return nil, err
+	}
+
+	return expandSplatTags(enhancedArg, splatTags, splatValues), nil
+}
+
+func (s *Service) replaceTags(request *dto.EnhanceArgsRequest, value string, tags entity.Tags, parents []string) (string, error) {
+	enhancedValue := value
+
+	for _, enhanceTag := range tags {
+		tagExpression, err := s.extractorService.ParseTag(enhanceTag)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to extract tag")
+		}
+
+		tagValue, err := s.resolveTag(request, tagExpression, parents)
+		if err != nil {
+			return "", err
+		}
+
+		enhancedValue = strings.ReplaceAll(enhancedValue, string(enhanceTag), tagValue)
+	}
+
+	return enhancedValue, nil
+}
+
+func (s *Service) resolveTag(request *dto.EnhanceArgsRequest, tagExpression *entity.TagExpression, parents []string) (string, error) {
+	if tagExpression.Filters.Contains(entity.JoinTagFilter) {
+		return s.resolveJoinedTag(request, tagExpression, parents)
+	}
+
+	tagValue, err := s.getTagValue(request, tagExpression.Name, parents)
+	if err != nil {
+		defaultValue, ok := tagExpression.Filters.GetDefault()
+		if !ok || errors.Is(err, domainerrors.ErrorTagCycle) || errors.Is(err, domainerrors.ErrorTagDepthExceeded) {
+			return "", errors.Wrapf(err, "failed to get tag value")
+		}
+
+		tagValue = defaultValue
+	}
+
+	return s.processTagValue(request, tagExpression, tagValue, parents)
+}
+
+// resolveJoinedTag joins the values of the tag, not its rendered value, so the join separator replaces the flag one.
+func (s *Service) resolveJoinedTag(request *dto.EnhanceArgsRequest, tagExpression *entity.TagExpression, parents []string) (string, error) {
+	tagValues, err := s.getResolvedTagValues(request, tagExpression, parents)
+	if err != nil {
+		return "", err
+	}
+
+	// an empty tag still renders one empty value, as it does without join, so default filters apply
+	if len(tagValues) == 0 {
+		tagValues = []string{""}
+	}
+
+	tagValue, err := s.filterService.ApplyValues(tagValues, tagExpression.Filters)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to apply tag filters")
+	}
+
+	return tagValue, nil
+}
+
+func (s *Service) resolveTagValues(request *dto.EnhanceArgsRequest, tagExpression *entity.TagExpression) ([]string, error) {
+	tagValues, err := s.getResolvedTagValues(request, tagExpression, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredValues := make([]string, 0, len(tagValues))
+
+	for _, tagValue := range tagValues {
+		filteredValue, err := s.filterService.Apply(tagValue, tagExpression.Filters)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to apply tag filters")
+		}
+
+		filteredValues = append(filteredValues, filteredValue)
+	}
+
+	return filteredValues, nil
+}
+
+// getResolvedTagValues returns the values of the tag with predefined args resolved and no filters applied.
+func (s *Service) getResolvedTagValues(
+	request *dto.EnhanceArgsRequest,
+	tagExpression *entity.TagExpression,
+	parents []string,
+) ([]string, error) {
+	tagValues, err := s.getTagValues(request, tagExpression.Name, parents)
+	if err != nil {
+		defaultValue, ok := tagExpression.Filters.GetDefault()
+		if !ok || errors.Is(err, domainerrors.ErrorTagCycle) || errors.Is(err, domainerrors.ErrorTagDepthExceeded) {
+			return nil, errors.Wrapf(err, "failed to get tag values")
+		}
+
+		tagValues = []string{defaultValue}
+	}
+
+	processedValues := make([]string, 0, len(tagValues))
+
+	for _, tagValue := range tagValues {
+		// an unset flag has no predefined arg to look up, its value is left to the default filter
+		if tagValue == "" || s.predefinedArgService == nil {
+			processedValues = append(processedValues, tagValue)
+
+			continue
+		}
+
+		predefinedValues, err := s.predefinedArgService.TryToFindPredefinedArgValues(&dto.TryToFindPredefinedArgRequest{
+			ParsedTag: tagExpression.Name,
+			Value:     tagValue,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to try to find predefined arg")
+		}
+
+		for _, predefinedValue := range predefinedValues {
+			processedValue, err := s.resolvePredefinedValue(request, tagExpression.Name, tagValue, predefinedValue, parents)
 			if err != nil {
-				return nil, errors.Wrapf(err, "failed to try to find predefined arg")
+				return nil, err
+			}
+
+			processedValues = append(processedValues, processedValue)
+		}
+	}
+
+	return processedValues, nil
+}
+
+func (s *Service) processTagValue(
+	request *dto.EnhanceArgsRequest,
+	tagExpression *entity.TagExpression,
+	tagValue string,
+	parents []string,
+) (string, error) {
+	if tagValue == "" || s.predefinedArgService == nil {
+		return s.applyTagValue(request, tagExpression, tagValue, tagValue, parents)
+	}
+
+	predefinedValue, err := s.predefinedArgService.TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
+		ParsedTag: tagExpression.Name,
+		Value:     tagValue,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to try to find predefined arg")
+	}
+
+	return s.applyTagValue(request, tagExpression, tagValue, predefinedValue, parents)
+}
+
+func (s *Service) applyTagValue(
+	request *dto.EnhanceArgsRequest,
+	tagExpression *entity.TagExpression,
+	tagValue string,
+	predefinedValue string,
+	parents []string,
+) (string, error) {
+	tagValue, err := s.resolvePredefinedValue(request, tagExpression.Name, tagValue, predefinedValue, parents)
+	if err != nil {
+		return "", err
+	}
+
+	tagValue, err = s.filterService.Apply(tagValue, tagExpression.Filters)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to apply tag filters")
+	}
+
+	return tagValue, nil
+}
+
+func (s *Service) resolvePredefinedValue(
+	request *dto.EnhanceArgsRequest,
+	name string,
+	tagValue string,
+	predefinedValue string,
+	parents []string,
+) (string, error) {
+	if predefinedValue == tagValue {
+		return tagValue, nil
+	}
+
+	value, err := s.resolveValue(request, name, predefinedValue, parents)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve predefined arg %s", name)
+	}
+
+	return value, nil
+}
+
+func (s *Service) getTagValues(request *dto.EnhanceArgsRequest, name string, parents []string) ([]string, error) {
+	return s.tagService.GetTagValues(s.newTagValueRequest(request, name, parents))
+}
+
+func (s *Service) getTagValue(request *dto.EnhanceArgsRequest, name string, parents []string) (string, error) {
+	return s.tagService.GetTagValue(s.newTagValueRequest(request, name, parents))
+}
+
+// newTagValueRequest lets providers resolve the tags nested in their values,
+// keeping track of the tags being resolved to detect cycles.
+func (s *Service) newTagValueRequest(request *dto.EnhanceArgsRequest, name string, parents []string) *dto.GetTagValueRequest {
+	return &dto.GetTagValueRequest{
+		Operation:    request.Operation,
+		Flags:        request.Flags,
+		ExtractedTag: name,
+		ResolveTags: func(value string) (string, error) {
+			return s.resolveValue(request, name, value, parents)
+		},
+	}
+}
+
+func (s *Service) resolveValue(request *dto.EnhanceArgsRequest, name string, value string, parents []string) (string, error) {
+	value = entity.ProtectEscapedTags(value)
+
+	tags := s.extractorService.ExtractTags(entity.Arg(value))
+	if len(tags) == 0 {
+		return value, nil
+	}
+
+	if slices.Contains(parents, name) {
+		return "", errors.Wrapf(domainerrors.ErrorTagCycle, "tags form a cycle: %s", strings.Join(append(parents, name), " -> "))
+	}
+
+	if len(parents) >= entity.MaxTagDepth {
+		return "", errors.Wrapf(domainerrors.ErrorTagDepthExceeded, "tag %s exceeds depth limit of %d", name, entity.MaxTagDepth)
+	}
+
+	return s.replaceTags(request, value, tags, append(slices.Clip(parents), name))
+}
+
+func expandSplatTags(arg string, splatTags entity.Tags, splatValues [][]string) []string {
+	args := []string{arg}
+
+	for i, splatTag := range splatTags {
+		expandedArgs := make([]string, 0, len(args)*len(splatValues[i]))
+
+		for _, expandedArg := range args {
+			for _, value := range splatValues[i] {
+				expandedArgs = append(expandedArgs, strings.ReplaceAll(expandedArg, string(splatTag), value))
 			}
+		}
+
+		args = expandedArgs
+	}
+
+	return args
+}
+
+func checkUnresolvedTags(operation config.Operation, arg string) error {
+	if operation.AllowUnresolvedTags || !strings.Contains(arg, entity.TagPrefix) {
+		return nil
+	}
 
-			tagValue = strings.ReplaceAll(arg, string(enhanceTag), tagValue)
+	return errors.Wrapf(domainerrors.ErrorUnresolvedTag, "arg '%s' contains an unresolved tag", arg)
+}
+
+// ValidateArgs checks the tags and filters of every value and returns all
+// problems as config.Errors.
+func (s *Service) ValidateArgs(values []config.TaggedValue) error {
+	var errs config.Errors
 
-			escapeArg, err := utils.EscapeValue(tagValue)
+	for _, value := range values {
+		for _, tag := range s.extractorService.ExtractTags(entity.Arg(value.Value)) {
+			tagExpression, err := s.extractorService.ParseTag(tag)
 			if err != nil {
-				return nil, errors.Wrap(err, "failed to escape arg")
+				errs.Add(value.Path, errors.Wrapf(err, "arg '%s' is not valid", value.Value))
+
+				continue
 			}
 
-			args[i] = escapeArg
+			if err = s.filterService.Validate(tagExpression.Filters); err != nil {
+				errs.Add(value.Path, errors.Wrapf(err, "arg '%s' is not valid", value.Value))
+			}
 		}
 	}
 
-	return args, nil
+	return errs.Err()
 }
 
 func (s *Service) GetEnhancedOperationArgs(request *dto.GetEnhancedOperationArgs) ([]string, error) {
@@ -120,17 +483,21 @@ func (s *Service) GetEnhancedOperationArgs(request *dto.GetEnhancedOperationArgs
 				return nil, errors.Wrap(err, "failed to extract tag")
 			}
 
-			if request.Operation.PredefinedArgsTag.Name == extractEnhanceTag {
+			for _, predefinedArgsTag := range request.Operation.GetPredefinedArgsTags() {
+				if predefinedArgsTag.Name != extractEnhanceTag {
+					continue
+				}
+
 				predefinedArgs, err = s.predefinedArgService.GetPredefinedArgValues(&dto.GetPredefinedArgsRequest{
 					Flags:             request.Flags,
-					PredefinedArgsTag: request.Operation.PredefinedArgsTag,
+					PredefinedArgsTag: &predefinedArgsTag,
 				},
 				)
 				if err != nil {
 					return nil, errors.Wrap(err, "failed to get predefined args")
 				}
 
-				continue
+				break
 			}
 		}
 
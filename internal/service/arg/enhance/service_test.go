@@ -1,6 +1,10 @@
 package enhance
 
 import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -11,6 +15,13 @@ import (
 	"project-helper/internal/domain/dto"
 	"project-helper/internal/domain/entity"
 	"project-helper/internal/service/arg/enhance/mocks"
+	"project-helper/internal/service/arg/predefined"
+	predefinedmocks "project-helper/internal/service/arg/predefined/mocks"
+	"project-helper/internal/service/tag"
+	"project-helper/internal/service/tag/env"
+	"project-helper/internal/service/tag/extractor"
+	"project-helper/internal/service/tag/filter"
+	flagstag "project-helper/internal/service/tag/flags"
 )
 
 func TestEnhanceArgs(t *testing.T) {
@@ -35,17 +46,15 @@ func TestEnhanceArgs(t *testing.T) {
 					Return(entity.Tags{"tag1"})
 				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg2")).
 					Return(entity.Tags{})
-				tc.extractorService.EXPECT().ExtractTag(entity.Tag("tag1")).
-					Return("tag_value1", nil)
-				tc.tagService.EXPECT().GetTagValue(&dto.GetTagValueRequest{
-					Operation:    operation,
-					Flags:        &flags,
-					ExtractedTag: "tag_value1",
-				}).Return("new_tag_value1", nil)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("tag1")).
+					Return(&entity.TagExpression{Name: "tag_value1"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "tag_value1")).Return("new_tag_value1", nil)
 				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
 					ParsedTag: "tag_value1",
 					Value:     "new_tag_value1",
 				}).Return(`"quoted_new_tag_value1"`, nil)
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg(`"quoted_new_tag_value1"`)).
+					Return(entity.Tags{})
 			},
 			input: &dto.EnhanceArgsRequest{
 				Operation: operation,
@@ -54,17 +63,58 @@ func TestEnhanceArgs(t *testing.T) {
 			},
 			expectedOutput: []string{"arg1 - \"quoted_new_tag_value1\"", "arg2"},
 		},
+		"success with multiple tags and filters": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("--set=${{env}}/${{branch}}")).
+					Return(entity.Tags{"${{env}}", "${{branch}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).
+					Return(&entity.TagExpression{Name: "env", Filters: entity.TagFilters{{Name: "default", Args: []string{"dev"}}}}, nil)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{branch}}")).
+					Return(&entity.TagExpression{Name: "branch", Filters: entity.TagFilters{
+						{Name: "lower"},
+						{Name: "replace", Args: []string{"/", "-"}},
+					}}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "env")).Return("", assert.AnError)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "branch")).Return("Feature/ABC-1", nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
+					ParsedTag: "env",
+					Value:     "dev",
+				}).Return("dev", nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
+					ParsedTag: "branch",
+					Value:     "Feature/ABC-1",
+				}).Return("Feature/ABC-1", nil)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"--set=${{env}}/${{branch}}"},
+			},
+			expectedOutput: []string{"--set=dev/feature-abc-1"},
+		},
+		"with error on unknown filter": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{env}}")).
+					Return(entity.Tags{"${{env}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).
+					Return(&entity.TagExpression{Name: "env", Filters: entity.TagFilters{{Name: "unknown"}}}, nil)
+				tc.tagService.EXPECT().GetTagValue(gomock.Any()).Return("dev", nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(gomock.Any()).Return("dev", nil)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"${{env}}"},
+			},
+			expectedError: errors.New("failed to apply tag filters: filters are not valid: unknown filter unknown"),
+		},
 		"with error on try to find predefined arg value": {
 			precondition: func(tc *testController) {
 				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg1 - tag1")).
 					Return(entity.Tags{"tag1"})
-				tc.extractorService.EXPECT().ExtractTag(entity.Tag("tag1")).
-					Return("tag_value1", nil)
-				tc.tagService.EXPECT().GetTagValue(&dto.GetTagValueRequest{
-					Operation:    operation,
-					Flags:        &flags,
-					ExtractedTag: "tag_value1",
-				}).Return("new_tag_value1", nil)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("tag1")).
+					Return(&entity.TagExpression{Name: "tag_value1"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "tag_value1")).Return("new_tag_value1", nil)
 				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
 					ParsedTag: "tag_value1",
 					Value:     "new_tag_value1",
@@ -81,13 +131,9 @@ func TestEnhanceArgs(t *testing.T) {
 			precondition: func(tc *testController) {
 				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg1 - tag1")).
 					Return(entity.Tags{"tag1"})
-				tc.extractorService.EXPECT().ExtractTag(entity.Tag("tag1")).
-					Return("tag_value1", nil)
-				tc.tagService.EXPECT().GetTagValue(&dto.GetTagValueRequest{
-					Operation:    operation,
-					Flags:        &flags,
-					ExtractedTag: "tag_value1",
-				}).Return("", assert.AnError)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("tag1")).
+					Return(&entity.TagExpression{Name: "tag_value1"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "tag_value1")).Return("", assert.AnError)
 			},
 			input: &dto.EnhanceArgsRequest{
 				Operation: operation,
@@ -96,12 +142,229 @@ func TestEnhanceArgs(t *testing.T) {
 			},
 			expectedError: errors.New("failed to get tag value: assert.AnError general error for testing"),
 		},
+		"success with composed vars": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("--image=${{image}}")).
+					Return(entity.Tags{"${{image}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{image}}")).
+					Return(&entity.TagExpression{Name: "image"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "image")).
+					DoAndReturn(resolveTags("${{registry}}/app:${{var.version}}"))
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{registry}}/app:${{var.version}}")).
+					Return(entity.Tags{"${{registry}}", "${{var.version}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{registry}}")).
+					Return(&entity.TagExpression{Name: "registry"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "registry")).
+					DoAndReturn(resolveTags("registry.example.com"))
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("registry.example.com")).Return(entity.Tags{})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{var.version}}")).
+					Return(&entity.TagExpression{Name: "var.version"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "var.version")).Return("v1.2.0", nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(gomock.Any()).
+					DoAndReturn(func(request *dto.TryToFindPredefinedArgRequest) (string, error) {
+						return request.Value, nil
+					}).Times(3)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"--image=${{image}}"},
+			},
+			expectedOutput: []string{"--image=registry.example.com/app:v1.2.0"},
+		},
+		"with vars cycle": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{first}}")).
+					Return(entity.Tags{"${{first}}"}).Times(2)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{first}}")).
+					Return(&entity.TagExpression{Name: "first"}, nil).Times(2)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "first")).
+					DoAndReturn(resolveTags("${{second}}")).Times(2)
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{second}}")).
+					Return(entity.Tags{"${{second}}"}).Times(2)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{second}}")).
+					Return(&entity.TagExpression{Name: "second"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "second")).
+					DoAndReturn(resolveTags("${{first}}"))
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"${{first}}"},
+			},
+			expectedError: errors.New("tags form a cycle: first -> second -> first"),
+		},
+		"success with tag in predefined arg value": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{env}}")).
+					Return(entity.Tags{"${{env}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).
+					Return(&entity.TagExpression{Name: "env"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "env")).Return("dev", nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
+					ParsedTag: "env",
+					Value:     "dev",
+				}).Return("--values=${{name}}.yaml", nil)
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("--values=${{name}}.yaml")).
+					Return(entity.Tags{"${{name}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{name}}")).
+					Return(&entity.TagExpression{Name: "name"}, nil)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "name")).Return("api", nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(&dto.TryToFindPredefinedArgRequest{
+					ParsedTag: "name",
+					Value:     "api",
+				}).Return("api", nil)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"${{env}}"},
+			},
+			expectedOutput: []string{"--values=api.yaml"},
+		},
+		"success with joined tag": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("--labels=${{labels | upper | join \";\"}}")).
+					Return(entity.Tags{"${{labels | upper | join \";\"}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{labels | upper | join \";\"}}")).
+					Return(&entity.TagExpression{Name: "labels", Filters: entity.TagFilters{
+						{Name: "upper"},
+						{Name: "join", Args: []string{";"}},
+					}}, nil)
+				tc.tagService.EXPECT().GetTagValues(tagRequest(operation, &flags, "labels")).Return([]string{"team=a,b", "tier=web"}, nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValues(gomock.Any()).
+					DoAndReturn(func(request *dto.TryToFindPredefinedArgRequest) ([]string, error) {
+						return []string{request.Value}, nil
+					}).Times(2)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"--labels=${{labels | upper | join \";\"}}"},
+			},
+			expectedOutput: []string{"--labels=TEAM=A,B;TIER=WEB"},
+		},
+		"success with splat tags": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("--set=${{services...}}.${{env}}=${{regions...}}")).
+					Return(entity.Tags{"${{services...}}", "${{env}}", "${{regions...}}"})
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{services...}}")).
+					Return(entity.Tags{"${{services...}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{services...}}")).
+					Return(&entity.TagExpression{Name: "services", Splat: true}, nil).Times(2)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).
+					Return(&entity.TagExpression{Name: "env"}, nil)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{regions...}}")).
+					Return(&entity.TagExpression{Name: "regions", Splat: true, Filters: entity.TagFilters{{Name: "upper"}}}, nil)
+				tc.tagService.EXPECT().GetTagValues(tagRequest(operation, &flags, "services")).Return([]string{"api", "web"}, nil).Times(2)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "env")).Return("dev", nil)
+				tc.tagService.EXPECT().GetTagValues(tagRequest(operation, &flags, "regions")).Return([]string{"eu", "us"}, nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(gomock.Any()).
+					DoAndReturn(func(request *dto.TryToFindPredefinedArgRequest) (string, error) {
+						return request.Value, nil
+					})
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValues(gomock.Any()).
+					DoAndReturn(func(request *dto.TryToFindPredefinedArgRequest) ([]string, error) {
+						return []string{request.Value}, nil
+					}).Times(6)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"--set=${{services...}}.${{env}}=${{regions...}}", "${{services...}}"},
+			},
+			expectedOutput: []string{
+				"--set=api.dev=EU",
+				"--set=api.dev=US",
+				"--set=web.dev=EU",
+				"--set=web.dev=US",
+				"api",
+				"web",
+			},
+		},
+		"success with empty splat tag": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{services...}}")).
+					Return(entity.Tags{"${{services...}}"})
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("./...")).
+					Return(entity.Tags{})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{services...}}")).
+					Return(&entity.TagExpression{Name: "services", Splat: true}, nil)
+				tc.tagService.EXPECT().GetTagValues(gomock.Any()).Return([]string{}, nil)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"${{services...}}", "./..."},
+			},
+			expectedOutput: []string{"./..."},
+		},
+		"success with escaped tags": {
+			precondition: func(tc *testController) {
+				tagExtractor := extractor.NewService()
+
+				tc.extractorService.EXPECT().ExtractTags(gomock.Any()).DoAndReturn(tagExtractor.ExtractTags).Times(2)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).DoAndReturn(tagExtractor.ParseTag)
+				tc.tagService.EXPECT().GetTagValue(tagRequest(operation, &flags, "env")).Return("dev", nil)
+				tc.predefinedArgService.EXPECT().TryToFindPredefinedArgValue(gomock.Any()).Return("dev", nil)
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"--ref=$${{github.sha}}-${{env}}", "$${{ matrix.os }}"},
+			},
+			expectedOutput: []string{"--ref=${{github.sha}}-dev", "${{ matrix.os }}"},
+		},
+		"with tag depth exceeded": {
+			precondition: func(tc *testController) {
+				tagExtractor := extractor.NewService()
+
+				tc.extractorService.EXPECT().ExtractTags(gomock.Any()).DoAndReturn(tagExtractor.ExtractTags).AnyTimes()
+				tc.extractorService.EXPECT().ParseTag(gomock.Any()).DoAndReturn(tagExtractor.ParseTag).AnyTimes()
+				tc.tagService.EXPECT().GetTagValue(gomock.Any()).
+					DoAndReturn(func(request *dto.GetTagValueRequest) (string, error) {
+						index, _ := strconv.Atoi(strings.TrimPrefix(request.ExtractedTag, "var"))
+
+						return request.ResolveTags(fmt.Sprintf("${{var%d}}", index+1))
+					}).AnyTimes()
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"${{var0}}"},
+			},
+			expectedError: errors.New("tag var10 exceeds depth limit of 10"),
+		},
+		"with unresolved tag": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{ github.sha }}")).
+					Return(entity.Tags{})
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: operation,
+				Flags:     &flags,
+				Args:      []string{"${{ github.sha }}"},
+			},
+			expectedError: errors.New("arg '${{ github.sha }}' contains an unresolved tag"),
+		},
+		"success with allowed unresolved tag": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{ github.sha }}")).
+					Return(entity.Tags{})
+			},
+			input: &dto.EnhanceArgsRequest{
+				Operation: config.Operation{Name: "operation", AllowUnresolvedTags: true},
+				Flags:     &flags,
+				Args:      []string{"${{ github.sha }}"},
+			},
+			expectedOutput: []string{"${{ github.sha }}"},
+		},
 		"with error on extract tag": {
 			precondition: func(tc *testController) {
 				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg1 - tag1")).
 					Return(entity.Tags{"tag1"})
-				tc.extractorService.EXPECT().ExtractTag(entity.Tag("tag1")).
-					Return("", assert.AnError)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("tag1")).
+					Return(nil, assert.AnError)
 			},
 			input: &dto.EnhanceArgsRequest{
 				Operation: operation,
@@ -183,6 +446,39 @@ func TestGetEnhancedOperationArgs(t *testing.T) {
 			},
 			expectedOutput: []string{"predefined_arg1", "predefined_arg2", "arg2"},
 		},
+		"success with several predefined args tags": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg1")).
+					Return(entity.Tags{"tag1"})
+				tc.extractorService.EXPECT().ExtractTag(entity.Tag("tag1")).
+					Return("env+region", nil)
+				tc.predefinedArgService.EXPECT().GetPredefinedArgValues(&dto.GetPredefinedArgsRequest{
+					Flags:             &flags,
+					PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env+region", Value: "clusters"},
+				}).Return([]string{"--cluster=prod-eu"}, nil)
+
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg2")).
+					Return(entity.Tags{"tag2"})
+				tc.extractorService.EXPECT().ExtractTag(entity.Tag("tag2")).
+					Return("env", nil)
+				tc.predefinedArgService.EXPECT().GetPredefinedArgValues(&dto.GetPredefinedArgsRequest{
+					Flags:             &flags,
+					PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env", Value: "envs"},
+				}).Return([]string{"--env=prod"}, nil)
+			},
+			input: &dto.GetEnhancedOperationArgs{
+				Operation: config.Operation{
+					Name: "operation",
+					Args: []string{"arg1", "arg2"},
+					PredefinedArgsTags: []config.PredefinedArgsTag{
+						{Name: "env", Value: "envs"},
+						{Name: "env+region", Value: "clusters"},
+					},
+				},
+				Flags: &flags,
+			},
+			expectedOutput: []string{"--cluster=prod-eu", "--env=prod"},
+		},
 		"with not predefined args": {
 			precondition: func(tc *testController) {
 				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg1")).
@@ -267,6 +563,143 @@ func TestGetEnhancedOperationArgs(t *testing.T) {
 	}
 }
 
+// TestEnhanceArgsWithPredefinedArgs resolves tags through the real tag and
+// predefined arg services, so empty flag values reach the predefined lookup.
+func TestEnhanceArgsWithPredefinedArgs(t *testing.T) {
+	t.Parallel()
+
+	predefinedArgs := map[string]config.PredefinedArg{
+		"region": {Name: "region", Args: config.Args{{Name: "eu", Values: []string{"eu-west-1"}}}},
+	}
+
+	tests := map[string]struct {
+		args           []string
+		flags          map[string]string
+		expectedOutput []string
+	}{
+		"default of unset flag": {
+			args:           []string{`--env=${{env | default "dev"}}`},
+			expectedOutput: []string{"--env=dev"},
+		},
+		"default of unset splat flag": {
+			args:           []string{`${{env... | default "dev"}}`},
+			expectedOutput: []string{"dev"},
+		},
+		"unset flag without default": {
+			args:           []string{"--env=${{env}}"},
+			expectedOutput: []string{"--env="},
+		},
+		"predefined arg of set flag": {
+			args:           []string{"--region=${{region}}"},
+			flags:          map[string]string{"region": "eu"},
+			expectedOutput: []string{"--region=eu-west-1"},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			sourceService := predefinedmocks.NewMockSourceService(ctrl)
+			sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(func(name string) (config.PredefinedArg, bool, error) {
+				predefinedArg, ok := predefinedArgs[name]
+
+				return predefinedArg, ok, nil
+			}).AnyTimes()
+
+			flags := entity.NewFlags()
+			flags.Operations = []string{"operation"}
+
+			for _, flagName := range []string{"env", "region"} {
+				value := testCase.flags[flagName]
+				flags.DynamicFlags[flagName] = &entity.DynamicFlagValue{Name: flagName, Type: entity.String, Value: &value}
+			}
+
+			tagService := tag.NewService()
+			tagService.Register("", flagstag.NewService())
+
+			extractorService := extractor.NewService()
+			service := NewService(
+				extractorService,
+				tagService,
+				predefined.NewService(predefinedmocks.NewMockConfigService(ctrl), sourceService, extractorService),
+				filter.NewService(),
+			)
+
+			actual, err := service.EnhanceArgs(&dto.EnhanceArgsRequest{
+				Operation: config.Operation{Name: "operation"},
+				Flags:     flags,
+				Args:      testCase.args,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOutput, actual)
+		})
+	}
+}
+
+func TestResolvePath(t *testing.T) {
+	t.Setenv("PH_TEST_HOME", "/home/user")
+	t.Setenv("PH_TEST_PROJECTS", "${{env.PH_TEST_HOME}}/projects")
+	t.Setenv("PH_TEST_LOOP", "${{env.PH_TEST_LOOP}}")
+
+	tagService := tag.NewService()
+	tagService.Register(entity.EnvTagNamespace, env.NewService())
+
+	service := NewPathService(extractor.NewService(), tagService, filter.NewService())
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"without tags": {
+			path:     "/opt/project",
+			expected: "/opt/project",
+		},
+		"with env tag": {
+			path:     "${{env.PH_TEST_HOME}}/project",
+			expected: "/home/user/project",
+		},
+		"with env tag in env value": {
+			path:     "${{env.PH_TEST_PROJECTS}}/app",
+			expected: "/home/user/projects/app",
+		},
+		"with env tag cycle": {
+			path:        `${{env.PH_TEST_LOOP | default "/tmp"}}`,
+			expectedErr: errors.New("tags form a cycle: env.PH_TEST_LOOP -> env.PH_TEST_LOOP"),
+		},
+		"with default for unset env tag": {
+			path:     `${{env.PH_TEST_MISSING | default "/tmp"}}/project`,
+			expected: "/tmp/project",
+		},
+		"with unset env tag": {
+			path:        "${{env.PH_TEST_MISSING}}/project",
+			expectedErr: errors.New("environment variable PH_TEST_MISSING is not set"),
+		},
+		"with not env tag": {
+			path:        "${{name}}/project",
+			expectedErr: errors.New("tag ${{name}} is not supported in path, only env tags are allowed"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			path, err := service.ResolvePath(testCase.path)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, path)
+			}
+		})
+	}
+}
+
 type testController struct {
 	extractorService     *mocks.MockExtractorService
 	tagService           *mocks.MockTagService
@@ -286,5 +719,123 @@ func (t *testController) Build() *Service {
 		t.extractorService,
 		t.tagService,
 		t.predefinedArgService,
+		filter.NewService(),
 	)
 }
+
+// tagRequestMatcher matches a tag value request by its fields, as the resolve
+// callback can't be compared.
+type tagRequestMatcher struct {
+	operation config.Operation
+	flags     *entity.Flags
+	tag       string
+}
+
+func tagRequest(operation config.Operation, flags *entity.Flags, tag string) gomock.Matcher {
+	return tagRequestMatcher{operation: operation, flags: flags, tag: tag}
+}
+
+func (m tagRequestMatcher) Matches(x any) bool {
+	request, ok := x.(*dto.GetTagValueRequest)
+
+	return ok && request.ResolveTags != nil && request.ExtractedTag == m.tag && request.Flags == m.flags &&
+		reflect.DeepEqual(request.Operation, m.operation)
+}
+
+func (m tagRequestMatcher) String() string {
+	return fmt.Sprintf("is a request of tag %s", m.tag)
+}
+
+// resolveTags returns a tag value made of other tags, as a var or an
+// environment variable is.
+func resolveTags(value string) func(request *dto.GetTagValueRequest) (string, error) {
+	return func(request *dto.GetTagValueRequest) (string, error) {
+		return request.ResolveTags(value)
+	}
+}
+
+func TestValidateArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		precondition  func(*testController)
+		values        []config.TaggedValue
+		expectedError error
+	}{
+		"valid": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{env | default \"dev\"}}")).
+					Return(entity.Tags{"${{env | default \"dev\"}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env | default \"dev\"}}")).
+					Return(&entity.TagExpression{Name: "env", Filters: entity.TagFilters{{Name: "default", Args: []string{"dev"}}}}, nil)
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("arg")).Return(entity.Tags{})
+			},
+			values: []config.TaggedValue{
+				{Path: "operations[run].args[0]", Value: "${{env | default \"dev\"}}"},
+				{Path: "operations[run].args[1]", Value: "arg"},
+			},
+		},
+		"with unknown filter": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{env | unknown}}")).
+					Return(entity.Tags{"${{env | unknown}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env | unknown}}")).
+					Return(&entity.TagExpression{Name: "env", Filters: entity.TagFilters{{Name: "unknown"}}}, nil)
+			},
+			values:        []config.TaggedValue{{Path: "operations[run].args[0]", Value: "${{env | unknown}}"}},
+			expectedError: errors.New("operations[run].args[0]: arg '${{env | unknown}}' is not valid: unknown filter unknown"),
+		},
+		"with parse error": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{env}}")).
+					Return(entity.Tags{"${{env}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).
+					Return(nil, assert.AnError)
+			},
+			values:        []config.TaggedValue{{Path: "vars[env].value", Value: "${{env}}"}},
+			expectedError: errors.New("vars[env].value: arg '${{env}}' is not valid: assert.AnError general error for testing"),
+		},
+		"with several problems": {
+			precondition: func(tc *testController) {
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{env | first}}-${{name | second}}")).
+					Return(entity.Tags{"${{env | first}}", "${{name | second}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env | first}}")).
+					Return(&entity.TagExpression{Name: "env", Filters: entity.TagFilters{{Name: "first"}}}, nil)
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{name | second}}")).
+					Return(&entity.TagExpression{Name: "name", Filters: entity.TagFilters{{Name: "second"}}}, nil)
+				tc.extractorService.EXPECT().ExtractTags(entity.Arg("${{env | upper \"x\"}}")).
+					Return(entity.Tags{"${{env | upper \"x\"}}"})
+				tc.extractorService.EXPECT().ParseTag(entity.Tag("${{env | upper \"x\"}}")).
+					Return(&entity.TagExpression{Name: "env", Filters: entity.TagFilters{{Name: "upper", Args: []string{"x"}}}}, nil)
+			},
+			values: []config.TaggedValue{
+				{Path: "operations[run].args[0]", Value: "${{env | first}}-${{name | second}}"},
+				{Path: "vars[image].value", Value: "${{env | upper \"x\"}}"},
+			},
+			expectedError: errors.New("operations[run].args[0]: arg '${{env | first}}-${{name | second}}' is not valid: unknown filter first; " +
+				"operations[run].args[0]: arg '${{env | first}}-${{name | second}}' is not valid: unknown filter second; " +
+				"vars[image].value: arg '${{env | upper \"x\"}}' is not valid: filter upper expects 0 argument(s), got 1"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.precondition != nil {
+				testCase.precondition(controller)
+			}
+
+			err := controller.Build().ValidateArgs(testCase.values)
+
+			if testCase.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
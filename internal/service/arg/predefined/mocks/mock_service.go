@@ -10,6 +10,7 @@ package mocks
 
 import (
 	config "project-helper/internal/config"
+	entity "project-helper/internal/domain/entity"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -38,16 +39,107 @@ func (m *MockConfigService) EXPECT() *MockConfigServiceMockRecorder {
 	return m.recorder
 }
 
-// GetPredefinedArgs mocks base method.
-func (m *MockConfigService) GetPredefinedArgs() map[string]config.PredefinedArg {
+// GetConfig mocks base method.
+func (m *MockConfigService) GetConfig() *config.Application {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPredefinedArgValues")
-	ret0, _ := ret[0].(map[string]config.PredefinedArg)
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*config.Application)
 	return ret0
 }
 
-// GetPredefinedArgs indicates an expected call of GetPredefinedArgs.
-func (mr *MockConfigServiceMockRecorder) GetPredefinedArgs() *gomock.Call {
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockConfigServiceMockRecorder) GetConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPredefinedArgValues", reflect.TypeOf((*MockConfigService)(nil).GetPredefinedArgs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockConfigService)(nil).GetConfig))
+}
+
+// MockSourceService is a mock of SourceService interface.
+type MockSourceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceServiceMockRecorder
+}
+
+// MockSourceServiceMockRecorder is the mock recorder for MockSourceService.
+type MockSourceServiceMockRecorder struct {
+	mock *MockSourceService
+}
+
+// NewMockSourceService creates a new mock instance.
+func NewMockSourceService(ctrl *gomock.Controller) *MockSourceService {
+	mock := &MockSourceService{ctrl: ctrl}
+	mock.recorder = &MockSourceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceService) EXPECT() *MockSourceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPredefinedArg mocks base method.
+func (m *MockSourceService) GetPredefinedArg(name string) (config.PredefinedArg, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPredefinedArg", name)
+	ret0, _ := ret[0].(config.PredefinedArg)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPredefinedArg indicates an expected call of GetPredefinedArg.
+func (mr *MockSourceServiceMockRecorder) GetPredefinedArg(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPredefinedArg", reflect.TypeOf((*MockSourceService)(nil).GetPredefinedArg), name)
+}
+
+// MockExtractorService is a mock of ExtractorService interface.
+type MockExtractorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtractorServiceMockRecorder
+}
+
+// MockExtractorServiceMockRecorder is the mock recorder for MockExtractorService.
+type MockExtractorServiceMockRecorder struct {
+	mock *MockExtractorService
+}
+
+// NewMockExtractorService creates a new mock instance.
+func NewMockExtractorService(ctrl *gomock.Controller) *MockExtractorService {
+	mock := &MockExtractorService{ctrl: ctrl}
+	mock.recorder = &MockExtractorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtractorService) EXPECT() *MockExtractorServiceMockRecorder {
+	return m.recorder
+}
+
+// ExtractTags mocks base method.
+func (m *MockExtractorService) ExtractTags(arg entity.Arg) entity.Tags {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTags", arg)
+	ret0, _ := ret[0].(entity.Tags)
+	return ret0
+}
+
+// ExtractTags indicates an expected call of ExtractTags.
+func (mr *MockExtractorServiceMockRecorder) ExtractTags(arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTags", reflect.TypeOf((*MockExtractorService)(nil).ExtractTags), arg)
+}
+
+// ParseTag mocks base method.
+func (m *MockExtractorService) ParseTag(tag entity.Tag) (*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTag", tag)
+	ret0, _ := ret[0].(*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTag indicates an expected call of ParseTag.
+func (mr *MockExtractorServiceMockRecorder) ParseTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTag", reflect.TypeOf((*MockExtractorService)(nil).ParseTag), tag)
 }
@@ -3,39 +3,76 @@ package predefined
 //go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	"project-helper/internal/config"
 	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
 	domainerrors "project-helper/internal/domain/errors"
 	"project-helper/internal/utils"
 )
 
 type (
 	ConfigService interface {
-		GetPredefinedArgs() map[string]config.PredefinedArg
+		GetConfig() *config.Application
+	}
+	SourceService interface {
+		GetPredefinedArg(name string) (config.PredefinedArg, bool, error)
+	}
+	ExtractorService interface {
+		ExtractTags(arg entity.Arg) entity.Tags
+		ParseTag(tag entity.Tag) (*entity.TagExpression, error)
 	}
 )
 
 type Service struct {
-	configService ConfigService
+	configService    ConfigService
+	sourceService    SourceService
+	extractorService ExtractorService
 }
 
-func NewService(configService ConfigService) *Service {
+func NewService(configService ConfigService, sourceService SourceService, extractorService ExtractorService) *Service {
 	return &Service{
-		configService: configService,
+		configService:    configService,
+		sourceService:    sourceService,
+		extractorService: extractorService,
 	}
 }
 
 func (s *Service) TryToFindPredefinedArgValue(request *dto.TryToFindPredefinedArgRequest) (string, error) {
+	values, predefinedArg, err := s.tryToFindPredefinedArgValues(request)
+	if err != nil {
+		return "", err
+	}
+
+	switch predefinedArg.Type {
+	case entity.Array, entity.Map:
+		return "", errors.Wrapf(domainerrors.ErrorPredefinedArgTypeMismatch,
+			"predefined arg %s is of type %s and must be used as a splat tag ${{%s...}}", request.ParsedTag, predefinedArg.Type, request.ParsedTag)
+	}
+
+	return strings.Join(values, ","), nil
+}
+
+func (s *Service) TryToFindPredefinedArgValues(request *dto.TryToFindPredefinedArgRequest) ([]string, error) {
+	values, _, err := s.tryToFindPredefinedArgValues(request)
+
+	return values, err
+}
+
+func (s *Service) tryToFindPredefinedArgValues(request *dto.TryToFindPredefinedArgRequest) ([]string, config.PredefinedArg, error) {
 	err := utils.Validate.Struct(request)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to validate request")
+		return nil, config.PredefinedArg{}, errors.Wrap(err, "failed to validate request")
 	}
 
-	arg := s.configService.GetPredefinedArgs()[request.ParsedTag]
+	arg, _, err := s.sourceService.GetPredefinedArg(request.ParsedTag)
+	if err != nil {
+		return nil, config.PredefinedArg{}, errors.Wrap(err, "failed to get predefined arg")
+	}
 
 	predefinedValue, err := arg.Args.GetArgValues(request.Value)
 	if err != nil {
@@ -44,10 +81,14 @@ func (s *Service) TryToFindPredefinedArgValue(request *dto.TryToFindPredefinedAr
 			Str("value", request.Value).
 			Err(err).Msgf("Failed to get predefined arg values")
 
-		return request.Value, nil
+		return []string{request.Value}, config.PredefinedArg{}, nil
+	}
+
+	if err = validateValues(arg, request.Value, predefinedValue); err != nil {
+		return nil, config.PredefinedArg{}, err
 	}
 
-	return strings.Join(predefinedValue, ","), nil
+	return predefinedValue, arg, nil
 }
 
 func (s *Service) GetPredefinedArgValues(request *dto.GetPredefinedArgsRequest) ([]string, error) {
@@ -56,24 +97,133 @@ func (s *Service) GetPredefinedArgValues(request *dto.GetPredefinedArgsRequest)
 		return nil, errors.Wrap(err, "failed to validate request")
 	}
 
-	predefinedArg, ok := s.configService.GetPredefinedArgs()[request.PredefinedArgsTag.Value]
-	if !ok {
-		return nil, errors.Wrapf(domainerrors.ErrorPredefinedArgNotFound, "predefined arg %s not found", request.PredefinedArgsTag.Value)
+	predefinedArg, err := s.getPredefinedArg(request.PredefinedArgsTag.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	flagNames := request.PredefinedArgsTag.GetFlagNames()
+	keys := make([]string, 0, len(flagNames))
+
+	for _, flagName := range flagNames {
+		key, err := request.Flags.GetRequiredFlagStringValue(flagName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get flag value")
+		}
+
+		keys = append(keys, key)
 	}
 
-	value, err := request.Flags.GetRequiredFlagStringValue(request.PredefinedArgsTag.Name)
+	values, _, err := getArgValues(predefinedArg, strings.Join(keys, config.CompositeKeySeparator))
+
+	return values, err
+}
+
+// GetArgValues returns the values of a key of a predefinedArgs table, falling
+// back to its catch-all key, along with the key that matched.
+func (s *Service) GetArgValues(name string, value string) ([]string, string, error) {
+	predefinedArg, err := s.getPredefinedArg(name)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get flag value")
+		return nil, "", err
 	}
 
-	values, err := predefinedArg.Args.GetArgValues(value)
+	return getArgValues(predefinedArg, value)
+}
+
+func (s *Service) getPredefinedArg(name string) (config.PredefinedArg, error) {
+	predefinedArg, ok, err := s.sourceService.GetPredefinedArg(name)
 	if err != nil {
-		values, err = predefinedArg.Args.GetArgValues("*")
-		if err == nil {
-			return values, nil
+		return config.PredefinedArg{}, errors.Wrap(err, "failed to get predefined arg")
+	}
+
+	if !ok {
+		return config.PredefinedArg{}, errors.Wrapf(domainerrors.ErrorPredefinedArgNotFound, "predefined arg %s not found", name)
+	}
+
+	return predefinedArg, nil
+}
+
+func getArgValues(predefinedArg config.PredefinedArg, value string) ([]string, string, error) {
+	arg, err := predefinedArg.Args.FindArg(value)
+	if err != nil {
+		arg, err = predefinedArg.Args.FindArg(config.CatchAllArg)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "failed to get arg values for value %s or common value (*)", value)
+		}
+	}
+
+	if err = validateValues(predefinedArg, arg.Name, arg.Values); err != nil {
+		return nil, "", err
+	}
+
+	return arg.Values, arg.Name, nil
+}
+
+// ValidateTypes checks the values of inline predefinedArgs against their type
+// and that array and map predefinedArgs are only used as splat tags.
+func (s *Service) ValidateTypes() error {
+	application := s.configService.GetConfig()
+	predefinedArgs := application.GetPredefinedArgs()
+
+	var errs config.Errors
+
+	for _, predefinedArg := range application.PredefinedArgs {
+		prefix := fmt.Sprintf("predefinedArgs[%s]", predefinedArg.Name)
+
+		switch predefinedArg.Type {
+		case "", entity.String, entity.Array, entity.Map:
+		default:
+			errs.Add(prefix+".type", errors.Errorf("predefined arg %s has unknown type %s", predefinedArg.Name, predefinedArg.Type))
+
+			continue
+		}
+
+		for _, arg := range predefinedArg.Args {
+			if err := validateValues(predefinedArg, arg.Name, arg.Values); err != nil {
+				errs.Add(fmt.Sprintf("%s.args[%s]", prefix, arg.Name), err)
+			}
+		}
+	}
+
+	for _, value := range application.GetTaggedValues() {
+		for _, tag := range s.extractorService.ExtractTags(entity.Arg(value.Value)) {
+			tagExpression, err := s.extractorService.ParseTag(tag)
+			if err != nil {
+				errs.Add(value.Path, errors.Wrapf(err, "failed to parse tag %s", tag))
+
+				continue
+			}
+
+			predefinedArg, ok := predefinedArgs[tagExpression.Name]
+			if !ok || tagExpression.Splat {
+				continue
+			}
+
+			if predefinedArg.Type == entity.Array || predefinedArg.Type == entity.Map {
+				errs.Add(value.Path, errors.Wrapf(domainerrors.ErrorPredefinedArgTypeMismatch,
+					"predefined arg %s is of type %s and must be used as a splat tag ${{%s...}}", tagExpression.Name, predefinedArg.Type, tagExpression.Name))
+			}
+		}
+	}
+
+	return errs.Err()
+}
+
+func validateValues(predefinedArg config.PredefinedArg, key string, values []string) error {
+	switch predefinedArg.Type {
+	case entity.String:
+		if len(values) != 1 {
+			return errors.Wrapf(domainerrors.ErrorPredefinedArgTypeMismatch,
+				"predefined arg %s is of type string but %s has %d values", predefinedArg.Name, key, len(values))
+		}
+	case entity.Map:
+		for _, value := range values {
+			if !strings.Contains(value, "=") {
+				return errors.Wrapf(domainerrors.ErrorPredefinedArgTypeMismatch,
+					"predefined arg %s is of type map but value '%s' of %s is not a key=value pair", predefinedArg.Name, value, key)
+			}
 		}
-		return nil, errors.Wrapf(err, "failed to get arg values for value %s or common value (*)", value)
 	}
 
-	return values, nil
+	return nil
 }
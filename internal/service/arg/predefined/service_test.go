@@ -24,7 +24,7 @@ func TestTryToFindPredefinedArg(t *testing.T) {
 	}{
 		"valid request": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().Return(map[string]config.PredefinedArg{
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
 					"parsed_tag": {
 						Args: config.Args{
 							{
@@ -33,7 +33,7 @@ func TestTryToFindPredefinedArg(t *testing.T) {
 							},
 						},
 					},
-				})
+				}))
 			},
 			request: &dto.TryToFindPredefinedArgRequest{
 				ParsedTag: "parsed_tag",
@@ -41,10 +41,44 @@ func TestTryToFindPredefinedArg(t *testing.T) {
 			},
 			expected: "parsed_tag_value1,parsed_tag_value2",
 		},
+		"valid request with glob arg": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"branch": {
+						Args: config.Args{
+							{Name: "*", Values: []string{"catch-all"}},
+							{Name: "feature/*", Values: []string{"--feature=${{match.1}}"}},
+						},
+					},
+				}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "branch",
+				Value:     "feature/login",
+			},
+			expected: "--feature=login",
+		},
+		"value without match keeps catch-all unused": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"branch": {
+						Args: config.Args{
+							{Name: "*", Values: []string{"catch-all"}},
+							{Name: "feature/*", Values: []string{"feature"}},
+						},
+					},
+				}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "branch",
+				Value:     "main",
+			},
+			expected: "main",
+		},
 		"valid request with no predefined args": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().
-					Return(map[string]config.PredefinedArg{})
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).
+					DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{}))
 			},
 			request: &dto.TryToFindPredefinedArgRequest{
 				ParsedTag: "parsed_tag",
@@ -52,6 +86,38 @@ func TestTryToFindPredefinedArg(t *testing.T) {
 			},
 			expected: "parsed_tag_value",
 		},
+		"array type used as a single value": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"env": {
+						Name: "env",
+						Type: entity.Array,
+						Args: config.Args{{Name: "dev", Values: []string{"--verbose", "--debug"}}},
+					},
+				}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "env",
+				Value:     "dev",
+			},
+			expectedErr: errors.New("predefined arg env is of type array and must be used as a splat tag ${{env...}}"),
+		},
+		"string type with several values": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"env": {
+						Name: "env",
+						Type: entity.String,
+						Args: config.Args{{Name: "dev", Values: []string{"a", "b"}}},
+					},
+				}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "env",
+				Value:     "dev",
+			},
+			expectedErr: errors.New("predefined arg env is of type string but dev has 2 values"),
+		},
 		"invalid request": {
 			preconditions: func(t *testController) {},
 			request:       &dto.TryToFindPredefinedArgRequest{},
@@ -83,6 +149,100 @@ func TestTryToFindPredefinedArg(t *testing.T) {
 	}
 }
 
+func TestTryToFindPredefinedArgValues(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		preconditions func(*testController)
+		request       *dto.TryToFindPredefinedArgRequest
+		expected      []string
+		expectedErr   error
+	}{
+		"array type": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"env": {
+						Name: "env",
+						Type: entity.Array,
+						Args: config.Args{{Name: "dev", Values: []string{"--verbose", "--debug"}}},
+					},
+				}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "env",
+				Value:     "dev",
+			},
+			expected: []string{"--verbose", "--debug"},
+		},
+		"map type": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"env": {
+						Name: "env",
+						Type: entity.Map,
+						Args: config.Args{{Name: "dev", Values: []string{"HOST=localhost", "PORT=8080"}}},
+					},
+				}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "env",
+				Value:     "dev",
+			},
+			expected: []string{"HOST=localhost", "PORT=8080"},
+		},
+		"map type with invalid value": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"env": {
+						Name: "env",
+						Type: entity.Map,
+						Args: config.Args{{Name: "dev", Values: []string{"localhost"}}},
+					},
+				}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "env",
+				Value:     "dev",
+			},
+			expectedErr: errors.New("predefined arg env is of type map but value 'localhost' of dev is not a key=value pair"),
+		},
+		"no match": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).
+					DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{}))
+			},
+			request: &dto.TryToFindPredefinedArgRequest{
+				ParsedTag: "env",
+				Value:     "dev",
+			},
+			expected: []string{"dev"},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			controller := newTestController(ctrl)
+			testCase.preconditions(controller)
+
+			service := controller.Build()
+
+			result, err := service.TryToFindPredefinedArgValues(testCase.request)
+
+			if testCase.expectedErr != nil {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expected, result)
+			}
+		})
+	}
+}
+
 func TestGetPredefinedArgs(t *testing.T) {
 	t.Parallel()
 
@@ -104,7 +264,7 @@ func TestGetPredefinedArgs(t *testing.T) {
 	}{
 		"valid request": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().Return(map[string]config.PredefinedArg{
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
 					"predefined_arg1_value": {
 						Args: config.Args{
 							{
@@ -113,7 +273,7 @@ func TestGetPredefinedArgs(t *testing.T) {
 							},
 						},
 					},
-				})
+				}))
 			},
 			request: &dto.GetPredefinedArgsRequest{
 				PredefinedArgsTag: &config.PredefinedArgsTag{
@@ -126,7 +286,7 @@ func TestGetPredefinedArgs(t *testing.T) {
 		},
 		"valid request with common args": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().Return(map[string]config.PredefinedArg{
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
 					"predefined_arg1_value": {
 						Args: config.Args{
 							{
@@ -135,7 +295,7 @@ func TestGetPredefinedArgs(t *testing.T) {
 							},
 						},
 					},
-				})
+				}))
 			},
 			request: &dto.GetPredefinedArgsRequest{
 				PredefinedArgsTag: &config.PredefinedArgsTag{
@@ -146,13 +306,139 @@ func TestGetPredefinedArgs(t *testing.T) {
 			},
 			expected: []string{"wildcard"},
 		},
+		"valid request with glob args": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"predefined_arg1_value": {
+						Args: config.Args{
+							{Name: "*", Values: []string{"wildcard"}},
+							{Name: "predefined_*", Values: []string{"short-glob"}},
+							{Name: "predefined_flag_*", Values: []string{"--name=${{match.1}}", "${{match.0}}"}},
+						},
+					},
+				}))
+			},
+			request: &dto.GetPredefinedArgsRequest{
+				PredefinedArgsTag: &config.PredefinedArgsTag{
+					Name:  "predefined_arg1",
+					Value: "predefined_arg1_value",
+				},
+				Flags: flags,
+			},
+			expected: []string{"--name=value", "predefined_flag_value"},
+		},
+		"valid request with regex args": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"predefined_arg1_value": {
+						Args: config.Args{
+							{Name: "predefined_flag_*", Values: []string{"glob"}},
+							{Name: `re:^predefined_(\w+)_value$`, Values: []string{"regex-${{match.1}}"}},
+							{Name: `re:^predefined`, Values: []string{"later-regex"}},
+						},
+					},
+				}))
+			},
+			request: &dto.GetPredefinedArgsRequest{
+				PredefinedArgsTag: &config.PredefinedArgsTag{
+					Name:  "predefined_arg1",
+					Value: "predefined_arg1_value",
+				},
+				Flags: flags,
+			},
+			expected: []string{"regex-flag"},
+		},
+		"valid request with glob more specific than regex": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"predefined_arg1_value": {
+						Args: config.Args{
+							{Name: `re:.*`, Values: []string{"regex"}},
+							{Name: "predefined_flag_*", Values: []string{"glob-${{match.1}}"}},
+						},
+					},
+				}))
+			},
+			request: &dto.GetPredefinedArgsRequest{
+				PredefinedArgsTag: &config.PredefinedArgsTag{
+					Name:  "predefined_arg1",
+					Value: "predefined_arg1_value",
+				},
+				Flags: flags,
+			},
+			expected: []string{"glob-value"},
+		},
+		"valid request with exact args before patterns": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"predefined_arg1_value": {
+						Args: config.Args{
+							{Name: `re:.*`, Values: []string{"regex"}},
+							{Name: "predefined_flag_value", Values: []string{"exact"}},
+						},
+					},
+				}))
+			},
+			request: &dto.GetPredefinedArgsRequest{
+				PredefinedArgsTag: &config.PredefinedArgsTag{
+					Name:  "predefined_arg1",
+					Value: "predefined_arg1_value",
+				},
+				Flags: flags,
+			},
+			expected: []string{"exact"},
+		},
+		"with invalid regex args": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"predefined_arg1_value": {
+						Args: config.Args{
+							{Name: `re:(`, Values: []string{"regex"}},
+						},
+					},
+				}))
+			},
+			request: &dto.GetPredefinedArgsRequest{
+				PredefinedArgsTag: &config.PredefinedArgsTag{
+					Name:  "predefined_arg1",
+					Value: "predefined_arg1_value",
+				},
+				Flags: flags,
+			},
+			expectedErr: errors.New("arg re:( has an invalid regex"),
+		},
+		"valid request with composite key": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
+					"clusters": {
+						Args: config.Args{
+							{Name: "prod+eu", Values: []string{"--cluster=prod-eu"}},
+							{Name: "prod+*", Values: []string{"--cluster=prod-${{match.1}}"}},
+						},
+					},
+				}))
+			},
+			request: &dto.GetPredefinedArgsRequest{
+				PredefinedArgsTag: &config.PredefinedArgsTag{
+					Name:  "env+region",
+					Value: "clusters",
+				},
+				Flags: &entity.Flags{
+					DynamicFlags: map[string]*entity.DynamicFlagValue{
+						"env":    {Name: "env", Type: entity.String, Value: utils.MakePointer("prod")},
+						"region": {Name: "region", Type: entity.String, Value: utils.MakePointer("us")},
+					},
+				},
+			},
+			expected: []string{"--cluster=prod-us"},
+		},
 		"without common args": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().Return(map[string]config.PredefinedArg{
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
 					"predefined_arg1_value": {
 						Args: config.Args{},
 					},
-				})
+				}))
 			},
 			request: &dto.GetPredefinedArgsRequest{
 				PredefinedArgsTag: &config.PredefinedArgsTag{
@@ -165,11 +451,11 @@ func TestGetPredefinedArgs(t *testing.T) {
 		},
 		"without required flag": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().Return(map[string]config.PredefinedArg{
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
 					"predefined_arg1_value": {
 						Args: config.Args{},
 					},
-				})
+				}))
 			},
 			request: &dto.GetPredefinedArgsRequest{
 				PredefinedArgsTag: &config.PredefinedArgsTag{},
@@ -179,7 +465,7 @@ func TestGetPredefinedArgs(t *testing.T) {
 		},
 		"without required flag string value": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().Return(map[string]config.PredefinedArg{
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{
 					"predefined_arg1_value": {
 						Args: config.Args{
 							{
@@ -188,7 +474,7 @@ func TestGetPredefinedArgs(t *testing.T) {
 							},
 						},
 					},
-				})
+				}))
 			},
 			request: &dto.GetPredefinedArgsRequest{
 				PredefinedArgsTag: &config.PredefinedArgsTag{
@@ -201,7 +487,7 @@ func TestGetPredefinedArgs(t *testing.T) {
 		},
 		"with err on get predefined args": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetPredefinedArgs().Return(map[string]config.PredefinedArg{})
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(map[string]config.PredefinedArg{}))
 			},
 			request: &dto.GetPredefinedArgsRequest{
 				PredefinedArgsTag: &config.PredefinedArgsTag{},
@@ -209,6 +495,19 @@ func TestGetPredefinedArgs(t *testing.T) {
 			},
 			expectedErr: errors.New("predefined arg  not found: predefined arg not found"),
 		},
+		"with err on predefined arg source": {
+			preconditions: func(t *testController) {
+				t.sourceService.EXPECT().GetPredefinedArg("predefined_arg1_value").Return(config.PredefinedArg{}, true, assert.AnError)
+			},
+			request: &dto.GetPredefinedArgsRequest{
+				PredefinedArgsTag: &config.PredefinedArgsTag{
+					Name:  "predefined_arg1",
+					Value: "predefined_arg1_value",
+				},
+				Flags: flags,
+			},
+			expectedErr: errors.New("failed to get predefined arg: assert.AnError general error for testing"),
+		},
 		"with invalid request": {
 			preconditions: func(t *testController) {},
 			request:       &dto.GetPredefinedArgsRequest{},
@@ -240,16 +539,141 @@ func TestGetPredefinedArgs(t *testing.T) {
 	}
 }
 
+func TestValidateTypes(t *testing.T) {
+	t.Parallel()
+
+	tagExpression := func(name string, splat bool) *entity.TagExpression {
+		return &entity.TagExpression{Name: name, Splat: splat}
+	}
+
+	tests := map[string]struct {
+		preconditions func(*testController)
+		expectedErr   error
+	}{
+		"valid types": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					PredefinedArgs: []config.PredefinedArg{
+						{Name: "name", Type: entity.String, Args: config.Args{{Name: "a", Values: []string{"b"}}}},
+						{Name: "env", Type: entity.Map, Args: config.Args{{Name: "dev", Values: []string{"HOST=localhost"}}}},
+					},
+					Operations: []config.Operation{
+						{Name: "run", Args: []string{"${{env...}}"}},
+					},
+				})
+				t.extractorService.EXPECT().ExtractTags(entity.Arg("${{env...}}")).Return(entity.Tags{"${{env...}}"})
+				t.extractorService.EXPECT().ExtractTags(gomock.Any()).Return(nil).Times(2)
+				t.extractorService.EXPECT().ParseTag(entity.Tag("${{env...}}")).Return(tagExpression("env", true), nil)
+			},
+		},
+		"unknown type": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					PredefinedArgs: []config.PredefinedArg{{Name: "env", Type: "list"}},
+				})
+			},
+			expectedErr: errors.New("predefinedArgs[env].type: predefined arg env has unknown type list"),
+		},
+		"string type with several values": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					PredefinedArgs: []config.PredefinedArg{
+						{Name: "name", Type: entity.String, Args: config.Args{{Name: "a", Values: []string{"b", "c"}}}},
+					},
+				})
+				t.extractorService.EXPECT().ExtractTags(gomock.Any()).Return(nil).Times(2)
+			},
+			expectedErr: errors.New("predefinedArgs[name].args[a]: predefined arg name is of type string but a has 2 values"),
+		},
+		"array type used without splat": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					PredefinedArgs: []config.PredefinedArg{
+						{Name: "env", Type: entity.Array, Args: config.Args{{Name: "dev", Values: []string{"a", "b"}}}},
+					},
+					Operations: []config.Operation{
+						{Name: "run", Args: []string{"${{env}}"}},
+					},
+				})
+				t.extractorService.EXPECT().ExtractTags(entity.Arg("${{env}}")).Return(entity.Tags{"${{env}}"})
+				t.extractorService.EXPECT().ExtractTags(gomock.Any()).Return(nil).Times(2)
+				t.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).Return(tagExpression("env", false), nil)
+			},
+			expectedErr: errors.New("operations[run].args[0]: predefined arg env is of type array and must be used as a splat tag ${{env...}}"),
+		},
+		"with several problems": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					PredefinedArgs: []config.PredefinedArg{
+						{Name: "t1", Type: "list"},
+						{Name: "t2", Type: "set"},
+						{Name: "name", Type: entity.String, Args: config.Args{{Name: "a", Values: []string{"b", "c"}}, {Name: "d"}}},
+						{Name: "env", Type: entity.Map, Args: config.Args{{Name: "dev", Values: []string{"HOST"}}}},
+					},
+					Operations: []config.Operation{
+						{Name: "run", Args: []string{"${{env}}", "--env=${{env}}"}},
+					},
+				})
+				t.extractorService.EXPECT().ExtractTags(entity.Arg("${{env}}")).Return(entity.Tags{"${{env}}"})
+				t.extractorService.EXPECT().ExtractTags(entity.Arg("--env=${{env}}")).Return(entity.Tags{"${{env}}"})
+				t.extractorService.EXPECT().ExtractTags(gomock.Any()).Return(nil).AnyTimes()
+				t.extractorService.EXPECT().ParseTag(entity.Tag("${{env}}")).Return(tagExpression("env", false), nil).Times(2)
+			},
+			expectedErr: errors.New("predefinedArgs[t1].type: predefined arg t1 has unknown type list; " +
+				"predefinedArgs[t2].type: predefined arg t2 has unknown type set; " +
+				"predefinedArgs[name].args[a]: predefined arg name is of type string but a has 2 values: predefined arg type mismatch; " +
+				"predefinedArgs[name].args[d]: predefined arg name is of type string but d has 0 values: predefined arg type mismatch; " +
+				"predefinedArgs[env].args[dev]: predefined arg env is of type map but value 'HOST' of dev is not a key=value pair: predefined arg type mismatch; " +
+				"operations[run].args[0]: predefined arg env is of type map and must be used as a splat tag ${{env...}}: predefined arg type mismatch; " +
+				"operations[run].args[1]: predefined arg env is of type map and must be used as a splat tag ${{env...}}: predefined arg type mismatch"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			controller := newTestController(ctrl)
+			testCase.preconditions(controller)
+
+			service := controller.Build()
+
+			err := service.ValidateTypes()
+
+			if testCase.expectedErr != nil {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func getPredefinedArg(predefinedArgs map[string]config.PredefinedArg) func(string) (config.PredefinedArg, bool, error) {
+	return func(name string) (config.PredefinedArg, bool, error) {
+		predefinedArg, ok := predefinedArgs[name]
+
+		return predefinedArg, ok, nil
+	}
+}
+
 type testController struct {
-	configService *mocks.MockConfigService
+	configService    *mocks.MockConfigService
+	sourceService    *mocks.MockSourceService
+	extractorService *mocks.MockExtractorService
 }
 
 func newTestController(ctrl *gomock.Controller) *testController {
 	return &testController{
-		configService: mocks.NewMockConfigService(ctrl),
+		configService:    mocks.NewMockConfigService(ctrl),
+		sourceService:    mocks.NewMockSourceService(ctrl),
+		extractorService: mocks.NewMockExtractorService(ctrl),
 	}
 }
 
 func (t *testController) Build() *Service {
-	return NewService(t.configService)
+	return NewService(t.configService, t.sourceService, t.extractorService)
 }
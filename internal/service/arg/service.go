@@ -4,6 +4,7 @@ package arg
 
 import (
 	"context"
+	"slices"
 
 	"github.com/pkg/errors"
 	"project-helper/internal/config"
@@ -50,24 +51,68 @@ func (s *Service) PrepareArgs(_ context.Context, operation config.Operation) ([]
 		return nil, errors.Wrap(err, "failed to enhance args")
 	}
 
-	if len(rawEnhancedArgs) == 0 {
-		return make([]string, 0), nil
+	args := make([]string, 0, len(rawEnhancedArgs))
+
+	for len(rawEnhancedArgs) != 0 {
+		end := slices.IndexFunc(rawEnhancedArgs, isPassThroughArgsTag)
+		if end == 0 {
+			args = append(args, flags.PassThroughArgs...)
+			rawEnhancedArgs = rawEnhancedArgs[1:]
+
+			continue
+		}
+
+		if end == -1 {
+			end = len(rawEnhancedArgs)
+		}
+
+		if operation.RawArgs {
+			args = append(args, rawEnhancedArgs[:end]...)
+			rawEnhancedArgs = rawEnhancedArgs[end:]
+
+			continue
+		}
+
+		enhancedArgs, err := s.enhanceArgService.EnhanceArgs(&dto.EnhanceArgsRequest{
+			Flags:     flags,
+			Operation: operation,
+			Args:      rawEnhancedArgs[:end],
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to enhance args")
+		}
+
+		args = append(args, enhancedArgs...)
+		rawEnhancedArgs = rawEnhancedArgs[end:]
+	}
+
+	return args, nil
+}
+
+func (s *Service) PrepareExecutionPath(_ context.Context, operation config.Operation) (string, error) {
+	if operation.ExecutionPath == "" {
+		return "", nil
 	}
 
-	args, err := s.enhanceArgService.EnhanceArgs(&dto.EnhanceArgsRequest{
-		Flags:     flags,
+	executionPaths, err := s.enhanceArgService.EnhanceArgs(&dto.EnhanceArgsRequest{
+		Flags:     s.flagService.GetOperationFlags(operation),
 		Operation: operation,
-		Args:      rawEnhancedArgs,
+		Args:      []string{operation.ExecutionPath},
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to enhance args")
+		return "", errors.Wrap(err, "failed to enhance execution path")
 	}
 
-	return args, nil
+	if len(executionPaths) != 1 {
+		return "", errors.Errorf("execution path %s must resolve to exactly one value, got %d", operation.ExecutionPath, len(executionPaths))
+	}
+
+	return executionPaths[0], nil
 }
 
 func (s *Service) getArgs(flags *entity.Flags, operation config.Operation) ([]string, error) {
-	if operation.PredefinedArgsTag == nil {
+	predefinedArgsTags := operation.GetPredefinedArgsTags()
+	if len(predefinedArgsTags) == 0 {
 		return operation.Args, nil
 	}
 
@@ -77,11 +122,22 @@ func (s *Service) getArgs(flags *entity.Flags, operation config.Operation) ([]st
 		} else {
 			return args, nil
 		}
-	} else {
-		if args, err := s.predefinedArgSvc.GetPredefinedArgValues(&dto.GetPredefinedArgsRequest{Flags: flags, PredefinedArgsTag: operation.PredefinedArgsTag}); err != nil {
+	}
+
+	var args []string
+
+	for _, predefinedArgsTag := range predefinedArgsTags {
+		values, err := s.predefinedArgSvc.GetPredefinedArgValues(&dto.GetPredefinedArgsRequest{Flags: flags, PredefinedArgsTag: &predefinedArgsTag})
+		if err != nil {
 			return nil, errors.Wrap(err, "failed to get predefined args")
-		} else {
-			return args, nil
 		}
+
+		args = append(args, values...)
 	}
+
+	return args, nil
+}
+
+func isPassThroughArgsTag(arg string) bool {
+	return arg == entity.PassThroughArgsTagValue
 }
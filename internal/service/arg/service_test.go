@@ -1,6 +1,7 @@
 package arg
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -71,6 +72,66 @@ func TestPrepareArgs(t *testing.T) {
 			},
 			expected: []string{"processed_enhanced_operation_arg1", "processed_enhanced_operation_arg2"},
 		},
+		"valid operation with pass through args": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(config.Operation{
+					Name: "test",
+					Args: []string{"test", "${{args}}", "./..."},
+				}).
+					Return(&entity.Flags{PassThroughArgs: []string{"-run", "${{TestFoo}}"}})
+				t.enhanceArgService.EXPECT().EnhanceArgs(&dto.EnhanceArgsRequest{
+					Flags: &entity.Flags{PassThroughArgs: []string{"-run", "${{TestFoo}}"}},
+					Operation: config.Operation{
+						Name: "test",
+						Args: []string{"test", "${{args}}", "./..."},
+					},
+					Args: []string{"test"},
+				}).Return([]string{"test"}, nil)
+				t.enhanceArgService.EXPECT().EnhanceArgs(&dto.EnhanceArgsRequest{
+					Flags: &entity.Flags{PassThroughArgs: []string{"-run", "${{TestFoo}}"}},
+					Operation: config.Operation{
+						Name: "test",
+						Args: []string{"test", "${{args}}", "./..."},
+					},
+					Args: []string{"./..."},
+				}).Return([]string{"./..."}, nil)
+			},
+			operation: config.Operation{
+				Name: "test",
+				Args: []string{"test", "${{args}}", "./..."},
+			},
+			expected: []string{"test", "-run", "${{TestFoo}}", "./..."},
+		},
+		"valid operation with raw args": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(config.Operation{
+					Name:    "test",
+					Args:    []string{"--format={{.Name}}", "${{env}}", "${{args}}"},
+					RawArgs: true,
+				}).
+					Return(&entity.Flags{PassThroughArgs: []string{"-v"}})
+			},
+			operation: config.Operation{
+				Name:    "test",
+				Args:    []string{"--format={{.Name}}", "${{env}}", "${{args}}"},
+				RawArgs: true,
+			},
+			expected: []string{"--format={{.Name}}", "${{env}}", "-v"},
+		},
+		"valid operation with empty pass through args": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(config.Operation{
+					Name: "test",
+					Args: []string{"${{args}}"},
+				}).
+					Return(&entity.Flags{})
+			},
+			operation: config.Operation{
+				Name: "test",
+				Args: []string{"${{args}}"},
+			},
+			expected: []string{},
+		},
 		"valid operation without enhanced operation args": {
 			preconditions: func(t *testController) {
 				t.flagService.EXPECT().GetOperationFlags(config.Operation{
@@ -113,6 +174,34 @@ func TestPrepareArgs(t *testing.T) {
 			},
 			expected: []string{"enhanced_predefined_arg1"},
 		},
+		"valid operation with several predefined args tags": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(gomock.Any()).
+					Return(&entity.Flags{})
+				t.predefinedArgSvc.EXPECT().GetPredefinedArgValues(&dto.GetPredefinedArgsRequest{
+					Flags:             &entity.Flags{},
+					PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env", Value: "envs"},
+				}).
+					Return([]string{"--env=prod"}, nil)
+				t.predefinedArgSvc.EXPECT().GetPredefinedArgValues(&dto.GetPredefinedArgsRequest{
+					Flags:             &entity.Flags{},
+					PredefinedArgsTag: &config.PredefinedArgsTag{Name: "region", Value: "regions"},
+				}).
+					Return([]string{"--region=eu"}, nil)
+				t.enhanceArgService.EXPECT().EnhanceArgs(gomock.Any()).
+					DoAndReturn(func(request *dto.EnhanceArgsRequest) ([]string, error) {
+						return request.Args, nil
+					})
+			},
+			operation: config.Operation{
+				Name: "test",
+				PredefinedArgsTags: []config.PredefinedArgsTag{
+					{Name: "env", Value: "envs"},
+					{Name: "region", Value: "regions"},
+				},
+			},
+			expected: []string{"--env=prod", "--region=eu"},
+		},
 		"valid operation without predefined args": {
 			preconditions: func(t *testController) {
 				t.flagService.EXPECT().GetOperationFlags(config.Operation{
@@ -231,6 +320,84 @@ func TestPrepareArgs(t *testing.T) {
 	}
 }
 
+func TestPrepareExecutionPath(t *testing.T) {
+	t.Parallel()
+
+	operation := config.Operation{
+		Name:          "test",
+		ChangePath:    true,
+		ExecutionPath: "${{env.SERVICE_DIR}}/api",
+	}
+
+	tests := map[string]struct {
+		preconditions func(*testController)
+		operation     config.Operation
+		expected      string
+		expectedErr   error
+	}{
+		"success": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(operation).Return(&entity.Flags{})
+				t.enhanceArgService.EXPECT().EnhanceArgs(&dto.EnhanceArgsRequest{
+					Flags:     &entity.Flags{},
+					Operation: operation,
+					Args:      []string{"${{env.SERVICE_DIR}}/api"},
+				}).Return([]string{"services/api"}, nil)
+			},
+			operation: operation,
+			expected:  "services/api",
+		},
+		"empty execution path": {
+			preconditions: func(t *testController) {},
+			operation:     config.Operation{Name: "test", ChangePath: true},
+		},
+		"with error on enhance args": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(operation).Return(&entity.Flags{})
+				t.enhanceArgService.EXPECT().EnhanceArgs(gomock.Any()).Return(nil, assert.AnError)
+			},
+			operation:   operation,
+			expectedErr: errors.New("failed to enhance execution path: assert.AnError general error for testing"),
+		},
+		"with empty splat": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(gomock.Any()).Return(&entity.Flags{})
+				t.enhanceArgService.EXPECT().EnhanceArgs(gomock.Any()).Return([]string{}, nil)
+			},
+			operation:   config.Operation{Name: "test", ExecutionPath: "${{dirs...}}"},
+			expectedErr: errors.New("execution path ${{dirs...}} must resolve to exactly one value, got 0"),
+		},
+		"with multi-value splat": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetOperationFlags(gomock.Any()).Return(&entity.Flags{})
+				t.enhanceArgService.EXPECT().EnhanceArgs(gomock.Any()).Return([]string{"api", "web"}, nil)
+			},
+			operation:   config.Operation{Name: "test", ExecutionPath: "${{dirs...}}"},
+			expectedErr: errors.New("execution path ${{dirs...}} must resolve to exactly one value, got 2"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			testCase.preconditions(controller)
+
+			executionPath, err := controller.Build().PrepareExecutionPath(context.Background(), testCase.operation)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, executionPath)
+			}
+		})
+	}
+}
+
 type testController struct {
 	flagService       *mocks.MockFlagService
 	enhanceArgService *mocks.MockEnhanceArgService
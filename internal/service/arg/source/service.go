@@ -0,0 +1,317 @@
+package source
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+	"project-helper/internal/config"
+)
+
+const (
+	cacheDirectory = "predefined-args"
+	defaultCmdTTL  = 5 * time.Minute
+	commentPrefix  = "#"
+)
+
+type (
+	ConfigService interface {
+		GetPredefinedArgs() map[string]config.PredefinedArg
+		GetApplicationPath() string
+	}
+)
+
+type cacheEntry struct {
+	Source    config.ArgsSource
+	CreatedAt time.Time
+	Args      config.Args
+}
+
+type Service struct {
+	configService ConfigService
+	cachePath     string
+	now           func() time.Time
+	loaded        map[string]config.PredefinedArg
+	mutex         sync.Mutex
+}
+
+func NewService(configService ConfigService, applicationCacheDirectory string) *Service {
+	return &Service{
+		configService: configService,
+		cachePath:     filepath.Join(applicationCacheDirectory, cacheDirectory),
+		now:           time.Now,
+		loaded:        make(map[string]config.PredefinedArg),
+	}
+}
+
+// GetPredefinedArg returns the predefinedArgs table with the args of its source
+// appended after the inline ones. Sources are loaded on first use.
+func (s *Service) GetPredefinedArg(name string) (config.PredefinedArg, bool, error) {
+	predefinedArg, ok := s.configService.GetPredefinedArgs()[name]
+	if !ok || predefinedArg.Source == nil {
+		return predefinedArg, ok, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if loaded, ok := s.loaded[name]; ok {
+		return loaded, true, nil
+	}
+
+	args, err := s.getSourceArgs(name, *predefinedArg.Source)
+	if err != nil {
+		return config.PredefinedArg{}, true, errors.Wrapf(err, "failed to load source of predefined arg %s", name)
+	}
+
+	predefinedArg.Args = append(append(config.Args{}, predefinedArg.Args...), args...)
+	s.loaded[name] = predefinedArg
+
+	return predefinedArg, true, nil
+}
+
+// ValidateSources checks the source of every predefinedArgs table and returns
+// all problems as config.Errors.
+func (s *Service) ValidateSources() error {
+	predefinedArgs := s.configService.GetPredefinedArgs()
+	names := make([]string, 0, len(predefinedArgs))
+
+	for name := range predefinedArgs {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	var errs config.Errors
+
+	for _, name := range names {
+		if source := predefinedArgs[name].Source; source != nil {
+			validateSource(fmt.Sprintf("predefinedArgs[%s].source", name), *source, &errs)
+		}
+	}
+
+	return errs.Err()
+}
+
+func (s *Service) getSourceArgs(name string, source config.ArgsSource) (config.Args, error) {
+	ttl := getTTL(source)
+	if ttl > 0 {
+		if args, ok := s.readCache(name, source, ttl); ok {
+			return args, nil
+		}
+	}
+
+	args, err := s.loadArgs(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		s.writeCache(name, source, args)
+	}
+
+	return args, nil
+}
+
+func (s *Service) loadArgs(source config.ArgsSource) (config.Args, error) {
+	switch {
+	case source.File != "":
+		return s.loadFile(source)
+	case source.Dir != "":
+		return s.loadDir(source)
+	case source.Cmd != "":
+		return s.loadCmd(source)
+	default:
+		return nil, errors.New("source has no file, dir or cmd")
+	}
+}
+
+func (s *Service) loadFile(source config.ArgsSource) (config.Args, error) {
+	content, err := os.ReadFile(s.resolvePath(source.File))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file %s", source.File)
+	}
+
+	switch strings.ToLower(filepath.Ext(source.File)) {
+	case ".yaml", ".yml", ".json":
+		return parseMap(source, content)
+	default:
+		return parseLines(source, content), nil
+	}
+}
+
+func (s *Service) loadDir(source config.ArgsSource) (config.Args, error) {
+	entries, err := os.ReadDir(s.resolvePath(source.Dir))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %s", source.Dir)
+	}
+
+	var args config.Args
+
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			args = append(args, source.NewArg(entry.Name()))
+		}
+	}
+
+	return args, nil
+}
+
+func (s *Service) loadCmd(source config.ArgsSource) (config.Args, error) {
+	command := exec.Command("sh", "-c", source.Cmd)
+	command.Dir = s.configService.GetApplicationPath()
+	command.Stderr = os.Stderr
+
+	output, err := command.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to run command %s", source.Cmd)
+	}
+
+	return parseLines(source, output), nil
+}
+
+func (s *Service) readCache(name string, source config.ArgsSource, ttl time.Duration) (config.Args, bool) {
+	content, err := os.ReadFile(s.getCacheFile(name))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err = yaml.Unmarshal(content, &entry); err != nil {
+		log.Debug().Err(err).Str("predefinedArg", name).Msg("Failed to read predefined args cache")
+
+		return nil, false
+	}
+
+	if !isSameSource(entry.Source, source) || s.now().Sub(entry.CreatedAt) > ttl {
+		return nil, false
+	}
+
+	return entry.Args, true
+}
+
+func (s *Service) writeCache(name string, source config.ArgsSource, args config.Args) {
+	content, err := yaml.Marshal(cacheEntry{
+		Source:    source,
+		CreatedAt: s.now(),
+		Args:      args,
+	})
+	if err == nil {
+		err = os.MkdirAll(s.cachePath, 0o755)
+	}
+
+	if err == nil {
+		err = os.WriteFile(s.getCacheFile(name), content, 0o644)
+	}
+
+	if err != nil {
+		log.Debug().Err(err).Str("predefinedArg", name).Msg("Failed to write predefined args cache")
+	}
+}
+
+func (s *Service) getCacheFile(name string) string {
+	return filepath.Join(s.cachePath, filepath.Base(name)+".yaml")
+}
+
+func (s *Service) resolvePath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(s.configService.GetApplicationPath(), path)
+}
+
+func validateSource(path string, source config.ArgsSource, errs *config.Errors) {
+	kinds := 0
+
+	for _, value := range []string{source.File, source.Dir, source.Cmd} {
+		if value != "" {
+			kinds++
+		}
+	}
+
+	if kinds != 1 {
+		errs.Add(path, errors.New("exactly one of file, dir and cmd must be set"))
+	}
+
+	if source.TTL < 0 {
+		errs.Add(path+".ttl", errors.New("ttl must not be negative"))
+	}
+}
+
+func isSameSource(a config.ArgsSource, b config.ArgsSource) bool {
+	return a.File == b.File && a.Dir == b.Dir && a.Cmd == b.Cmd && a.TTL == b.TTL && slices.Equal(a.Values, b.Values)
+}
+
+func getTTL(source config.ArgsSource) time.Duration {
+	if source.TTL == 0 && source.Cmd != "" {
+		return defaultCmdTTL
+	}
+
+	return source.TTL
+}
+
+func parseMap(source config.ArgsSource, content []byte) (config.Args, error) {
+	var entries yaml.MapSlice
+	if err := yaml.Unmarshal(content, &entries); err != nil {
+		return nil, errors.Wrap(err, "failed to parse file")
+	}
+
+	args := make(config.Args, 0, len(entries))
+
+	for _, entry := range entries {
+		key := fmt.Sprint(entry.Key)
+
+		switch value := entry.Value.(type) {
+		case nil:
+			args = append(args, source.NewArg(key))
+		case []any:
+			values := make([]string, 0, len(value))
+			for _, item := range value {
+				values = append(values, fmt.Sprint(item))
+			}
+
+			args = append(args, config.Arg{Name: key, Values: values})
+		case yaml.MapSlice:
+			// values of a map table are kept as key=value pairs in file order, as inline ones are
+			values := make([]string, 0, len(value))
+			for _, item := range value {
+				values = append(values, fmt.Sprintf("%v=%v", item.Key, item.Value))
+			}
+
+			args = append(args, config.Arg{Name: key, Values: values})
+		default:
+			args = append(args, config.Arg{Name: key, Values: []string{fmt.Sprint(value)}})
+		}
+	}
+
+	return args, nil
+}
+
+func parseLines(source config.ArgsSource, content []byte) config.Args {
+	var args config.Args
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		key := strings.TrimSpace(scanner.Text())
+		if key == "" || strings.HasPrefix(key, commentPrefix) {
+			continue
+		}
+
+		args = append(args, source.NewArg(key))
+	}
+
+	return args
+}
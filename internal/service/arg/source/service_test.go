@@ -0,0 +1,246 @@
+package source
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/service/arg/source/mocks"
+)
+
+func TestGetPredefinedArg(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile(t, dir, "clusters.yaml", "prod: [\"--context=prod\", \"--wait\"]\ndev: --context=dev\nlocal:\n")
+	writeFile(t, dir, "env.yaml", "dev:\n  PORT: 8080\n  HOST: local\n")
+	writeFile(t, dir, "regions.txt", "# regions\neu\n\nus\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "services", "api"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "services", "web"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "services", ".git"), 0o755))
+	writeFile(t, dir, "services/README.md", "")
+
+	tests := map[string]struct {
+		predefinedArg config.PredefinedArg
+		expected      config.Args
+		expectedErr   error
+	}{
+		"without source": {
+			predefinedArg: config.PredefinedArg{
+				Args: config.Args{{Name: "prod", Values: []string{"--prod"}}},
+			},
+			expected: config.Args{{Name: "prod", Values: []string{"--prod"}}},
+		},
+		"map file": {
+			predefinedArg: config.PredefinedArg{
+				Args:   config.Args{{Name: "prod", Values: []string{"--inline"}}},
+				Source: &config.ArgsSource{File: "clusters.yaml"},
+			},
+			expected: config.Args{
+				{Name: "prod", Values: []string{"--inline"}},
+				{Name: "prod", Values: []string{"--context=prod", "--wait"}},
+				{Name: "dev", Values: []string{"--context=dev"}},
+				{Name: "local", Values: []string{"local"}},
+			},
+		},
+		"map file of map table": {
+			predefinedArg: config.PredefinedArg{
+				Type:   entity.Map,
+				Source: &config.ArgsSource{File: "env.yaml"},
+			},
+			expected: config.Args{
+				{Name: "dev", Values: []string{"PORT=8080", "HOST=local"}},
+			},
+		},
+		"lines file with values": {
+			predefinedArg: config.PredefinedArg{
+				Source: &config.ArgsSource{File: "regions.txt", Values: []string{"--region=${{match.0}}"}},
+			},
+			expected: config.Args{
+				{Name: "eu", Values: []string{"--region=eu"}},
+				{Name: "us", Values: []string{"--region=us"}},
+			},
+		},
+		"directory": {
+			predefinedArg: config.PredefinedArg{
+				Source: &config.ArgsSource{Dir: "services", Values: []string{"./services/${{match.0}}"}},
+			},
+			expected: config.Args{
+				{Name: "api", Values: []string{"./services/api"}},
+				{Name: "web", Values: []string{"./services/web"}},
+			},
+		},
+		"command": {
+			predefinedArg: config.PredefinedArg{
+				Source: &config.ArgsSource{Cmd: "printf 'a\\nb\\n'"},
+			},
+			expected: config.Args{
+				{Name: "a", Values: []string{"a"}},
+				{Name: "b", Values: []string{"b"}},
+			},
+		},
+		"missing file": {
+			predefinedArg: config.PredefinedArg{
+				Source: &config.ArgsSource{File: "missing.yaml"},
+			},
+			expectedErr: errors.New("failed to load source of predefined arg table: failed to read file missing.yaml"),
+		},
+		"failing command": {
+			predefinedArg: config.PredefinedArg{
+				Source: &config.ArgsSource{Cmd: "exit 1"},
+			},
+			expectedErr: errors.New("failed to run command exit 1"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+			controller.configService.EXPECT().GetPredefinedArgs().
+				Return(map[string]config.PredefinedArg{"table": testCase.predefinedArg}).AnyTimes()
+			controller.configService.EXPECT().GetApplicationPath().Return(dir).AnyTimes()
+
+			predefinedArg, ok, err := controller.Build(t.TempDir()).GetPredefinedArg("table")
+
+			assert.True(t, ok)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, predefinedArg.Args)
+			}
+		})
+	}
+}
+
+func TestGetPredefinedArgCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cacheDir := t.TempDir()
+
+	predefinedArgs := map[string]config.PredefinedArg{
+		"table": {
+			Source: &config.ArgsSource{Cmd: "echo run >> counter && wc -l < counter", TTL: time.Minute},
+		},
+	}
+
+	controller := newTestController(gomock.NewController(t))
+	controller.configService.EXPECT().GetPredefinedArgs().Return(predefinedArgs).AnyTimes()
+	controller.configService.EXPECT().GetApplicationPath().Return(dir).AnyTimes()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	load := func() string {
+		service := controller.Build(cacheDir)
+		service.now = func() time.Time { return now }
+
+		predefinedArg, _, err := service.GetPredefinedArg("table")
+		require.NoError(t, err)
+		require.Len(t, predefinedArg.Args, 1)
+
+		return predefinedArg.Args[0].Name
+	}
+
+	assert.Equal(t, "1", load())
+	assert.Equal(t, "1", load(), "a fresh cache entry is reused across runs")
+
+	now = now.Add(2 * time.Minute)
+
+	assert.Equal(t, "2", load(), "an expired cache entry is reloaded")
+
+	predefinedArgs["table"].Source.TTL = time.Hour
+
+	assert.Equal(t, "3", load(), "a changed source invalidates the cache")
+}
+
+func TestValidateSources(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		predefinedArgs map[string]config.PredefinedArg
+		expectedErr    error
+	}{
+		"without source": {
+			predefinedArgs: map[string]config.PredefinedArg{"table": {}},
+		},
+		"valid source": {
+			predefinedArgs: map[string]config.PredefinedArg{"table": {Source: &config.ArgsSource{Dir: "services"}}},
+		},
+		"without kind": {
+			predefinedArgs: map[string]config.PredefinedArg{"table": {Source: &config.ArgsSource{}}},
+			expectedErr:    errors.New("predefinedArgs[table].source: exactly one of file, dir and cmd must be set"),
+		},
+		"with several kinds": {
+			predefinedArgs: map[string]config.PredefinedArg{"table": {Source: &config.ArgsSource{Dir: "services", Cmd: "ls"}}},
+			expectedErr:    errors.New("predefinedArgs[table].source: exactly one of file, dir and cmd must be set"),
+		},
+		"with negative ttl": {
+			predefinedArgs: map[string]config.PredefinedArg{"table": {Source: &config.ArgsSource{Cmd: "ls", TTL: -time.Second}}},
+			expectedErr:    errors.New("predefinedArgs[table].source.ttl: ttl must not be negative"),
+		},
+		"with several problems": {
+			predefinedArgs: map[string]config.PredefinedArg{
+				"services": {Source: &config.ArgsSource{TTL: -time.Second}},
+				"envs":     {Source: &config.ArgsSource{File: "envs.txt", Cmd: "ls"}},
+				"valid":    {Source: &config.ArgsSource{Cmd: "ls"}},
+			},
+			expectedErr: errors.New("predefinedArgs[envs].source: exactly one of file, dir and cmd must be set; " +
+				"predefinedArgs[services].source: exactly one of file, dir and cmd must be set; " +
+				"predefinedArgs[services].source.ttl: ttl must not be negative"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+			controller.configService.EXPECT().GetPredefinedArgs().Return(testCase.predefinedArgs)
+
+			err := controller.Build(t.TempDir()).ValidateSources()
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+type testController struct {
+	configService *mocks.MockConfigService
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService: mocks.NewMockConfigService(ctrl),
+	}
+}
+
+func (t *testController) Build(cacheDirectory string) *Service {
+	return NewService(t.configService, cacheDirectory)
+}
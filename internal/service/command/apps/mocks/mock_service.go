@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	config "project-helper/internal/config"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigService is a mock of ConfigService interface.
+type MockConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigServiceMockRecorder
+}
+
+// MockConfigServiceMockRecorder is the mock recorder for MockConfigService.
+type MockConfigServiceMockRecorder struct {
+	mock *MockConfigService
+}
+
+// NewMockConfigService creates a new mock instance.
+func NewMockConfigService(ctrl *gomock.Controller) *MockConfigService {
+	mock := &MockConfigService{ctrl: ctrl}
+	mock.recorder = &MockConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigService) EXPECT() *MockConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationName mocks base method.
+func (m *MockConfigService) GetApplicationName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetApplicationName indicates an expected call of GetApplicationName.
+func (mr *MockConfigServiceMockRecorder) GetApplicationName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationName", reflect.TypeOf((*MockConfigService)(nil).GetApplicationName))
+}
+
+// GetApplications mocks base method.
+func (m *MockConfigService) GetApplications() []config.Application {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplications")
+	ret0, _ := ret[0].([]config.Application)
+	return ret0
+}
+
+// GetApplications indicates an expected call of GetApplications.
+func (mr *MockConfigServiceMockRecorder) GetApplications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplications", reflect.TypeOf((*MockConfigService)(nil).GetApplications))
+}
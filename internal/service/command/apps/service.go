@@ -0,0 +1,65 @@
+package apps
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/config"
+)
+
+type (
+	ConfigService interface {
+		GetApplications() []config.Application
+		GetApplicationName() string
+	}
+)
+
+type Service struct {
+	configService ConfigService
+	writer        io.Writer
+}
+
+func NewService(configService ConfigService, writer io.Writer) *Service {
+	return &Service{
+		configService: configService,
+		writer:        writer,
+	}
+}
+
+// Run lists the applications of the config, marking the selected one.
+func (s *Service) Run(_ context.Context, args []string) error {
+	if len(args) != 0 {
+		return errors.Errorf("unexpected arguments: %v", args)
+	}
+
+	applications := s.configService.GetApplications()
+	if len(applications) == 0 {
+		_, err := fmt.Fprintln(s.writer, "no applications configured")
+
+		return err
+	}
+
+	selected := s.configService.GetApplicationName()
+
+	for _, application := range applications {
+		marker := " "
+		if application.Name == selected {
+			marker = "*"
+		}
+
+		line := marker + " " + application.Name
+		if application.Path != "" {
+			line += ": " + application.Path
+		}
+
+		if _, err := fmt.Fprintln(s.writer, line); err != nil {
+			return errors.Wrap(err, "failed to write applications")
+		}
+	}
+
+	return nil
+}
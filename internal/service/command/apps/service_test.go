@@ -0,0 +1,169 @@
+package apps
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/service/command/apps/mocks"
+	configservice "project-helper/internal/service/config"
+	configmocks "project-helper/internal/service/config/mocks"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		preconditions  func(*testController)
+		args           []string
+		expectedOutput string
+		expectedErr    error
+	}{
+		"with selected application": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetApplications().Return([]config.Application{
+					{Name: "api", Path: "/src/api"},
+					{Name: "web", Path: "/src/web"},
+					{Name: "scripts"},
+				})
+				t.configService.EXPECT().GetApplicationName().Return("web")
+			},
+			expectedOutput: "  api: /src/api\n" +
+				"* web: /src/web\n" +
+				"  scripts\n",
+		},
+		"without selected application": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetApplications().Return([]config.Application{{Name: "api", Path: "/src/api"}})
+				t.configService.EXPECT().GetApplicationName().Return("")
+			},
+			expectedOutput: "  api: /src/api\n",
+		},
+		"without applications": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetApplications().Return(nil)
+			},
+			expectedOutput: "no applications configured\n",
+		},
+		"with arguments": {
+			args:        []string{"list"},
+			expectedErr: errors.New("unexpected arguments: [list]"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			err := controller.Build().Run(context.Background(), testCase.args)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.expectedOutput, controller.output.String())
+		})
+	}
+}
+
+func TestRunWithSelectedApplication(t *testing.T) {
+	// The working directory is inside the api application.
+	apiPath, err := os.Getwd()
+	require.NoError(t, err)
+
+	webPath := t.TempDir()
+	configPath := filepath.Join(t.TempDir(), "application.yaml")
+
+	err = os.WriteFile(configPath, []byte(fmt.Sprintf(`applications:
+  - name: api
+    path: %s
+  - name: web
+    path: %s
+`, apiPath, webPath)), 0o600)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		application    string
+		applicationEnv string
+		expectedOutput string
+	}{
+		"by working directory": {
+			expectedOutput: fmt.Sprintf("* api: %s\n  web: %s\n", apiPath, webPath),
+		},
+		"by flag": {
+			application:    "web",
+			expectedOutput: fmt.Sprintf("  api: %s\n* web: %s\n", apiPath, webPath),
+		},
+		"by env": {
+			applicationEnv: "web",
+			expectedOutput: fmt.Sprintf("  api: %s\n* web: %s\n", apiPath, webPath),
+		},
+		"by flag with unknown application in env": {
+			application:    "web",
+			applicationEnv: "gone",
+			expectedOutput: fmt.Sprintf("  api: %s\n* web: %s\n", apiPath, webPath),
+		},
+		"with unknown application in env": {
+			applicationEnv: "gone",
+			expectedOutput: fmt.Sprintf("  api: %s\n  web: %s\n", apiPath, webPath),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("CONFIG_PATH", configPath)
+			t.Setenv(configservice.ApplicationEnv, testCase.applicationEnv)
+
+			pathResolver := configmocks.NewMockPathResolver(gomock.NewController(t))
+			pathResolver.EXPECT().ResolvePath(gomock.Any()).DoAndReturn(func(path string) (string, error) {
+				return path, nil
+			}).AnyTimes()
+
+			configService, err := configservice.NewService(pathResolver)
+			require.NoError(t, err)
+
+			// A failed selection still lists the applications.
+			_ = configService.SelectApplication(testCase.application)
+
+			output := &bytes.Buffer{}
+
+			err = NewService(configService, output).Run(context.Background(), nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOutput, output.String())
+		})
+	}
+}
+
+type testController struct {
+	configService *mocks.MockConfigService
+	output        *bytes.Buffer
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService: mocks.NewMockConfigService(ctrl),
+		output:        &bytes.Buffer{},
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(t.configService, t.output)
+}
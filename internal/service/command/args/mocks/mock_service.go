@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	config "project-helper/internal/config"
+	dto "project-helper/internal/domain/dto"
+	entity "project-helper/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigService is a mock of ConfigService interface.
+type MockConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigServiceMockRecorder
+}
+
+// MockConfigServiceMockRecorder is the mock recorder for MockConfigService.
+type MockConfigServiceMockRecorder struct {
+	mock *MockConfigService
+}
+
+// NewMockConfigService creates a new mock instance.
+func NewMockConfigService(ctrl *gomock.Controller) *MockConfigService {
+	mock := &MockConfigService{ctrl: ctrl}
+	mock.recorder = &MockConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigService) EXPECT() *MockConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// GetConfig mocks base method.
+func (m *MockConfigService) GetConfig() *config.Application {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*config.Application)
+	return ret0
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockConfigServiceMockRecorder) GetConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockConfigService)(nil).GetConfig))
+}
+
+// MockSourceService is a mock of SourceService interface.
+type MockSourceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceServiceMockRecorder
+}
+
+// MockSourceServiceMockRecorder is the mock recorder for MockSourceService.
+type MockSourceServiceMockRecorder struct {
+	mock *MockSourceService
+}
+
+// NewMockSourceService creates a new mock instance.
+func NewMockSourceService(ctrl *gomock.Controller) *MockSourceService {
+	mock := &MockSourceService{ctrl: ctrl}
+	mock.recorder = &MockSourceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceService) EXPECT() *MockSourceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPredefinedArg mocks base method.
+func (m *MockSourceService) GetPredefinedArg(name string) (config.PredefinedArg, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPredefinedArg", name)
+	ret0, _ := ret[0].(config.PredefinedArg)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPredefinedArg indicates an expected call of GetPredefinedArg.
+func (mr *MockSourceServiceMockRecorder) GetPredefinedArg(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPredefinedArg", reflect.TypeOf((*MockSourceService)(nil).GetPredefinedArg), name)
+}
+
+// MockPredefinedArgService is a mock of PredefinedArgService interface.
+type MockPredefinedArgService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPredefinedArgServiceMockRecorder
+}
+
+// MockPredefinedArgServiceMockRecorder is the mock recorder for MockPredefinedArgService.
+type MockPredefinedArgServiceMockRecorder struct {
+	mock *MockPredefinedArgService
+}
+
+// NewMockPredefinedArgService creates a new mock instance.
+func NewMockPredefinedArgService(ctrl *gomock.Controller) *MockPredefinedArgService {
+	mock := &MockPredefinedArgService{ctrl: ctrl}
+	mock.recorder = &MockPredefinedArgServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPredefinedArgService) EXPECT() *MockPredefinedArgServiceMockRecorder {
+	return m.recorder
+}
+
+// GetArgValues mocks base method.
+func (m *MockPredefinedArgService) GetArgValues(name, value string) ([]string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArgValues", name, value)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArgValues indicates an expected call of GetArgValues.
+func (mr *MockPredefinedArgServiceMockRecorder) GetArgValues(name, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArgValues", reflect.TypeOf((*MockPredefinedArgService)(nil).GetArgValues), name, value)
+}
+
+// MockReferenceService is a mock of ReferenceService interface.
+type MockReferenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferenceServiceMockRecorder
+}
+
+// MockReferenceServiceMockRecorder is the mock recorder for MockReferenceService.
+type MockReferenceServiceMockRecorder struct {
+	mock *MockReferenceService
+}
+
+// NewMockReferenceService creates a new mock instance.
+func NewMockReferenceService(ctrl *gomock.Controller) *MockReferenceService {
+	mock := &MockReferenceService{ctrl: ctrl}
+	mock.recorder = &MockReferenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferenceService) EXPECT() *MockReferenceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOperationTags mocks base method.
+func (m *MockReferenceService) GetOperationTags(application *config.Application, operation config.Operation) ([]*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationTags", application, operation)
+	ret0, _ := ret[0].([]*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationTags indicates an expected call of GetOperationTags.
+func (mr *MockReferenceServiceMockRecorder) GetOperationTags(application, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationTags", reflect.TypeOf((*MockReferenceService)(nil).GetOperationTags), application, operation)
+}
+
+// MockFlagParserService is a mock of FlagParserService interface.
+type MockFlagParserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlagParserServiceMockRecorder
+}
+
+// MockFlagParserServiceMockRecorder is the mock recorder for MockFlagParserService.
+type MockFlagParserServiceMockRecorder struct {
+	mock *MockFlagParserService
+}
+
+// NewMockFlagParserService creates a new mock instance.
+func NewMockFlagParserService(ctrl *gomock.Controller) *MockFlagParserService {
+	mock := &MockFlagParserService{ctrl: ctrl}
+	mock.recorder = &MockFlagParserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFlagParserService) EXPECT() *MockFlagParserServiceMockRecorder {
+	return m.recorder
+}
+
+// ParseDynamicFlags mocks base method.
+func (m *MockFlagParserService) ParseDynamicFlags(args []string) (*entity.Flags, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseDynamicFlags", args)
+	ret0, _ := ret[0].(*entity.Flags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseDynamicFlags indicates an expected call of ParseDynamicFlags.
+func (mr *MockFlagParserServiceMockRecorder) ParseDynamicFlags(args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseDynamicFlags", reflect.TypeOf((*MockFlagParserService)(nil).ParseDynamicFlags), args)
+}
+
+// MockEnhanceArgService is a mock of EnhanceArgService interface.
+type MockEnhanceArgService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnhanceArgServiceMockRecorder
+}
+
+// MockEnhanceArgServiceMockRecorder is the mock recorder for MockEnhanceArgService.
+type MockEnhanceArgServiceMockRecorder struct {
+	mock *MockEnhanceArgService
+}
+
+// NewMockEnhanceArgService creates a new mock instance.
+func NewMockEnhanceArgService(ctrl *gomock.Controller) *MockEnhanceArgService {
+	mock := &MockEnhanceArgService{ctrl: ctrl}
+	mock.recorder = &MockEnhanceArgServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnhanceArgService) EXPECT() *MockEnhanceArgServiceMockRecorder {
+	return m.recorder
+}
+
+// EnhanceArgs mocks base method.
+func (m *MockEnhanceArgService) EnhanceArgs(request *dto.EnhanceArgsRequest) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnhanceArgs", request)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnhanceArgs indicates an expected call of EnhanceArgs.
+func (mr *MockEnhanceArgServiceMockRecorder) EnhanceArgs(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnhanceArgs", reflect.TypeOf((*MockEnhanceArgService)(nil).EnhanceArgs), request)
+}
@@ -0,0 +1,288 @@
+package args
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+	domainerrors "project-helper/internal/domain/errors"
+)
+
+type (
+	ConfigService interface {
+		GetConfig() *config.Application
+	}
+	SourceService interface {
+		GetPredefinedArg(name string) (config.PredefinedArg, bool, error)
+	}
+	PredefinedArgService interface {
+		GetArgValues(name string, value string) ([]string, string, error)
+	}
+	ReferenceService interface {
+		GetOperationTags(application *config.Application, operation config.Operation) ([]*entity.TagExpression, error)
+	}
+	FlagParserService interface {
+		ParseDynamicFlags(args []string) (*entity.Flags, error)
+	}
+	EnhanceArgService interface {
+		EnhanceArgs(request *dto.EnhanceArgsRequest) ([]string, error)
+	}
+)
+
+type Service struct {
+	configService        ConfigService
+	sourceService        SourceService
+	predefinedArgService PredefinedArgService
+	referenceService     ReferenceService
+	flagParserService    FlagParserService
+	enhanceArgService    EnhanceArgService
+	writer               io.Writer
+}
+
+func NewService(
+	configService ConfigService,
+	sourceService SourceService,
+	predefinedArgService PredefinedArgService,
+	referenceService ReferenceService,
+	flagParserService FlagParserService,
+	enhanceArgService EnhanceArgService,
+	writer io.Writer,
+) *Service {
+	return &Service{
+		configService:        configService,
+		sourceService:        sourceService,
+		predefinedArgService: predefinedArgService,
+		referenceService:     referenceService,
+		flagParserService:    flagParserService,
+		enhanceArgService:    enhanceArgService,
+		writer:               writer,
+	}
+}
+
+func (s *Service) Run(_ context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("subcommand is required: list, resolve")
+	}
+
+	switch args[0] {
+	case "list":
+		return s.list(args[1:])
+	case "resolve":
+		return s.resolve(args[1:])
+	default:
+		return errors.Errorf("unknown subcommand %s, expected: list, resolve", args[0])
+	}
+}
+
+func (s *Service) list(names []string) error {
+	application := s.configService.GetConfig()
+
+	for _, name := range names {
+		if _, ok := application.GetPredefinedArgs()[name]; !ok {
+			return errors.Wrapf(domainerrors.ErrorPredefinedArgNotFound, "predefined arg %s not found", name)
+		}
+	}
+
+	for _, configPredefinedArg := range application.PredefinedArgs {
+		if configPredefinedArg.Name == "" || (len(names) != 0 && !slices.Contains(names, configPredefinedArg.Name)) {
+			continue
+		}
+
+		// A failing source is reported with its table, the other tables are
+		// still listed.
+		predefinedArg, _, sourceErr := s.sourceService.GetPredefinedArg(configPredefinedArg.Name)
+		if sourceErr != nil {
+			predefinedArg = configPredefinedArg
+		}
+
+		references, err := s.getReferences(application, predefinedArg.Name)
+		if err != nil {
+			return err
+		}
+
+		if err = s.writePredefinedArg(predefinedArg, references, sourceErr); err != nil {
+			return errors.Wrap(err, "failed to write predefined arg")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) writePredefinedArg(predefinedArg config.PredefinedArg, references []string, sourceErr error) error {
+	header := predefinedArg.Name
+	if predefinedArg.Type != "" {
+		header += fmt.Sprintf(" (%s)", predefinedArg.Type)
+	}
+
+	if len(references) == 0 {
+		references = []string{"none"}
+	}
+
+	lines := []string{
+		header,
+		"  used by: " + strings.Join(references, ", "),
+	}
+
+	if source := predefinedArg.Source; source != nil {
+		switch {
+		case source.File != "":
+			lines = append(lines, "  source: file "+source.File)
+		case source.Dir != "":
+			lines = append(lines, "  source: dir "+source.Dir)
+		case source.Cmd != "":
+			lines = append(lines, "  source: cmd "+source.Cmd)
+		}
+	}
+
+	if sourceErr != nil {
+		lines = append(lines, "  error: "+sourceErr.Error())
+	}
+
+	for _, arg := range predefinedArg.Args {
+		if len(arg.Values) == 0 {
+			lines = append(lines, "  "+arg.Name)
+
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("  %s: %s", arg.Name, strings.Join(arg.Values, ", ")))
+	}
+
+	_, err := fmt.Fprintln(s.writer, strings.Join(lines, "\n"))
+
+	return err
+}
+
+// getReferences lists the operations using a predefinedArgs table through one
+// of their predefinedArgsTag bindings or a tag they reach, as validation does.
+func (s *Service) getReferences(application *config.Application, name string) ([]string, error) {
+	var references []string
+
+	for _, operation := range application.Operations {
+		bound := slices.ContainsFunc(operation.GetPredefinedArgsTags(), func(predefinedArgsTag config.PredefinedArgsTag) bool {
+			return predefinedArgsTag.Value == name
+		})
+		if bound {
+			references = append(references, operation.Name+" (predefinedArgsTag)")
+		}
+
+		tags, err := s.referenceService.GetOperationTags(application, operation)
+		if err != nil {
+			return nil, err
+		}
+
+		used := slices.ContainsFunc(tags, func(tagExpression *entity.TagExpression) bool {
+			return tagExpression.Name == name
+		})
+		if used {
+			references = append(references, operation.Name+" (tag)")
+		}
+	}
+
+	return references, nil
+}
+
+func (s *Service) resolve(args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: args resolve <predefined arg> <key> [--operation <operation>] [flags]")
+	}
+
+	name, value := args[0], args[1]
+
+	values, key, err := s.predefinedArgService.GetArgValues(name, value)
+	if err != nil {
+		return errors.Wrap(err, "failed to get predefined arg values")
+	}
+
+	flags, err := s.flagParserService.ParseDynamicFlags(args[2:])
+	if err != nil {
+		return errors.Wrap(err, "failed to parse flags")
+	}
+
+	lines := []string{"matched: " + key}
+
+	if len(values) != 0 {
+		operation, err := s.getResolveOperation(name, values, flags.Operations)
+		if err != nil {
+			return err
+		}
+
+		values, err = s.enhanceArgService.EnhanceArgs(&dto.EnhanceArgsRequest{
+			Flags:     flags,
+			Operation: operation,
+			Args:      values,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to resolve values")
+		}
+	}
+
+	for _, resolvedValue := range values {
+		lines = append(lines, "  "+resolvedValue)
+	}
+
+	_, err = fmt.Fprintln(s.writer, strings.Join(lines, "\n"))
+
+	return err
+}
+
+// getResolveOperation returns the operation given with --operation. Without
+// one, the values are resolved on their own and can't use tags that depend on
+// an operation, such as its vars or execution path.
+func (s *Service) getResolveOperation(name string, values []string, operations []string) (config.Operation, error) {
+	application := s.configService.GetConfig()
+
+	switch len(operations) {
+	case 0:
+	case 1:
+		operation, ok := application.GetOperationsMap()[operations[0]]
+		if !ok {
+			return config.Operation{}, errors.Wrapf(domainerrors.ErrorOperationNotFound, "operation %s not found", operations[0])
+		}
+
+		return operation, nil
+	default:
+		return config.Operation{}, errors.Errorf("values can be resolved for one operation, got %s", strings.Join(operations, ", "))
+	}
+
+	tags, err := s.referenceService.GetOperationTags(application, config.Operation{Name: name, Args: values})
+	if err != nil {
+		return config.Operation{}, err
+	}
+
+	globalVars := application.GetVars(config.Operation{})
+
+	for _, tagExpression := range tags {
+		varName := strings.TrimPrefix(tagExpression.Name, entity.VarTagNamespace+".")
+
+		_, isGlobalVar := globalVars[varName]
+		if tagExpression.Name == entity.ExecutionPathTag || (!isGlobalVar && isOperationVar(application, varName)) {
+			return config.Operation{}, errors.Errorf(
+				"predefined arg %s uses the operation tag %s, pass the operation with --%s", name, tagExpression.Name, entity.OperationFlag,
+			)
+		}
+	}
+
+	// the values aren't resolved for an operation, the request only names the table for errors
+	return config.Operation{Name: name}, nil
+}
+
+func isOperationVar(application *config.Application, name string) bool {
+	for _, operation := range application.Operations {
+		for _, variable := range operation.Vars {
+			if variable.Name == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
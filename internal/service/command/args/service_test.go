@@ -0,0 +1,297 @@
+package args
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/service/command/args/mocks"
+	"project-helper/internal/service/tag/extractor"
+	"project-helper/internal/service/tag/reference"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	application := &config.Application{
+		PredefinedArgs: config.PredefinedArgs{
+			{
+				Name: "env",
+				Type: entity.Map,
+				Args: config.Args{
+					{Name: "dev", Values: []string{"HOST=localhost", "PORT=8080"}},
+				},
+			},
+			{
+				Name:   "service",
+				Source: &config.ArgsSource{Dir: "services"},
+			},
+			{
+				Name: "dead",
+				Args: config.Args{{Name: "key", Values: []string{"value"}}},
+			},
+		},
+		Operations: config.Operations{
+			{
+				Name:              "deploy",
+				PredefinedArgsTag: &config.PredefinedArgsTag{Name: "service", Value: "service"},
+				Args:              []string{"--env=${{env...}}"},
+			},
+			{
+				Name:    "raw",
+				RawArgs: true,
+				Args:    []string{"${{dead}}"},
+			},
+			{
+				Name: "build",
+				Vars: config.Vars{{Name: "image", Value: "app"}},
+			},
+		},
+	}
+
+	expectList := func(t *testController) {
+		t.configService.EXPECT().GetConfig().Return(application)
+		t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(func(name string) (config.PredefinedArg, bool, error) {
+			predefinedArg := application.GetPredefinedArgs()[name]
+			if name == "service" {
+				predefinedArg.Args = config.Args{{Name: "api"}, {Name: "web"}}
+			}
+
+			return predefinedArg, true, nil
+		}).AnyTimes()
+	}
+
+	tests := map[string]struct {
+		preconditions  func(*testController)
+		args           []string
+		expectedOutput string
+		expectedErr    error
+	}{
+		"list": {
+			preconditions: expectList,
+			args:          []string{"list"},
+			expectedOutput: "env (map)\n" +
+				"  used by: deploy (tag)\n" +
+				"  dev: HOST=localhost, PORT=8080\n" +
+				"service\n" +
+				"  used by: deploy (predefinedArgsTag)\n" +
+				"  source: dir services\n" +
+				"  api\n" +
+				"  web\n" +
+				"dead\n" +
+				"  used by: none\n" +
+				"  key: value\n",
+		},
+		"list selected predefined args": {
+			preconditions: expectList,
+			args:          []string{"list", "dead"},
+			expectedOutput: "dead\n" +
+				"  used by: none\n" +
+				"  key: value\n",
+		},
+		"list with error on source": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(application)
+				t.sourceService.EXPECT().GetPredefinedArg("service").Return(config.PredefinedArg{}, false, assert.AnError)
+				t.sourceService.EXPECT().GetPredefinedArg("dead").Return(application.GetPredefinedArgs()["dead"], true, nil)
+			},
+			args: []string{"list", "service", "dead"},
+			expectedOutput: "service\n" +
+				"  used by: deploy (predefinedArgsTag)\n" +
+				"  source: dir services\n" +
+				"  error: assert.AnError general error for testing\n" +
+				"dead\n" +
+				"  used by: none\n" +
+				"  key: value\n",
+		},
+		"list predefined args used through other tables and vars": {
+			preconditions: func(t *testController) {
+				nested := &config.Application{
+					Vars: config.Vars{{Name: "image", Value: "${{registry}}/app"}},
+					PredefinedArgs: config.PredefinedArgs{
+						{Name: "target", Args: config.Args{{Name: "prod", Values: []string{"${{flag-table}}.txt"}}}},
+						{Name: "flag-table", Args: config.Args{{Name: "key", Values: []string{"value"}}}},
+						{Name: "registry", Args: config.Args{{Name: "eu", Values: []string{"eu.registry"}}}},
+					},
+					Operations: config.Operations{
+						{Name: "deploy", PredefinedArgsTag: &config.PredefinedArgsTag{Name: "flag-table", Value: "target"}},
+						{Name: "push", Args: []string{"${{image}}"}},
+					},
+				}
+
+				t.configService.EXPECT().GetConfig().Return(nested)
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(func(name string) (config.PredefinedArg, bool, error) {
+					return nested.GetPredefinedArgs()[name], true, nil
+				}).AnyTimes()
+			},
+			args: []string{"list", "flag-table", "registry"},
+			expectedOutput: "flag-table\n" +
+				"  used by: deploy (tag)\n" +
+				"  key: value\n" +
+				"registry\n" +
+				"  used by: push (tag)\n" +
+				"  eu: eu.registry\n",
+		},
+		"list unknown predefined arg": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(application)
+			},
+			args:        []string{"list", "unknown"},
+			expectedErr: errors.New("predefined arg unknown not found"),
+		},
+		"resolve": {
+			preconditions: func(t *testController) {
+				flags := entity.NewFlags()
+
+				t.predefinedArgService.EXPECT().GetArgValues("version", "feature/login").
+					Return([]string{"${{branch}}-${{env}}"}, "feature/*", nil)
+				t.flagParserService.EXPECT().ParseDynamicFlags([]string{"--env=prod"}).Return(flags, nil)
+				t.configService.EXPECT().GetConfig().Return(application)
+				t.enhanceArgService.EXPECT().EnhanceArgs(&dto.EnhanceArgsRequest{
+					Flags:     flags,
+					Operation: config.Operation{Name: "version"},
+					Args:      []string{"${{branch}}-${{env}}"},
+				}).Return([]string{"feature/login-prod"}, nil)
+			},
+			args:           []string{"resolve", "version", "feature/login", "--env=prod"},
+			expectedOutput: "matched: feature/*\n  feature/login-prod\n",
+		},
+		"resolve for operation": {
+			preconditions: func(t *testController) {
+				flags := entity.NewFlags()
+				flags.Operations = []string{"build"}
+
+				t.predefinedArgService.EXPECT().GetArgValues("version", "main").
+					Return([]string{"${{image}}:${{execution-path}}"}, "main", nil)
+				t.flagParserService.EXPECT().ParseDynamicFlags([]string{"--operation=build"}).Return(flags, nil)
+				t.configService.EXPECT().GetConfig().Return(application)
+				t.enhanceArgService.EXPECT().EnhanceArgs(&dto.EnhanceArgsRequest{
+					Flags:     flags,
+					Operation: application.Operations[2],
+					Args:      []string{"${{image}}:${{execution-path}}"},
+				}).Return([]string{"app:/project"}, nil)
+			},
+			args:           []string{"resolve", "version", "main", "--operation=build"},
+			expectedOutput: "matched: main\n  app:/project\n",
+		},
+		"resolve for unknown operation": {
+			preconditions: func(t *testController) {
+				flags := entity.NewFlags()
+				flags.Operations = []string{"unknown"}
+
+				t.predefinedArgService.EXPECT().GetArgValues("version", "main").Return([]string{"${{env}}"}, "main", nil)
+				t.flagParserService.EXPECT().ParseDynamicFlags([]string{"-o", "unknown"}).Return(flags, nil)
+				t.configService.EXPECT().GetConfig().Return(application)
+			},
+			args:        []string{"resolve", "version", "main", "-o", "unknown"},
+			expectedErr: errors.New("operation unknown not found"),
+		},
+		"resolve with operation var and without operation": {
+			preconditions: func(t *testController) {
+				t.predefinedArgService.EXPECT().GetArgValues("version", "main").Return([]string{"${{var.image}}"}, "main", nil)
+				t.flagParserService.EXPECT().ParseDynamicFlags([]string{}).Return(entity.NewFlags(), nil)
+				t.configService.EXPECT().GetConfig().Return(application)
+			},
+			args:        []string{"resolve", "version", "main"},
+			expectedErr: errors.New("predefined arg version uses the operation tag var.image, pass the operation with --operation"),
+		},
+		"resolve with execution path and without operation": {
+			preconditions: func(t *testController) {
+				t.predefinedArgService.EXPECT().GetArgValues("version", "main").Return([]string{"${{execution-path}}"}, "main", nil)
+				t.flagParserService.EXPECT().ParseDynamicFlags([]string{}).Return(entity.NewFlags(), nil)
+				t.configService.EXPECT().GetConfig().Return(application)
+			},
+			args:        []string{"resolve", "version", "main"},
+			expectedErr: errors.New("predefined arg version uses the operation tag execution-path, pass the operation with --operation"),
+		},
+		"resolve with error on get values": {
+			preconditions: func(t *testController) {
+				t.predefinedArgService.EXPECT().GetArgValues("version", "main").Return(nil, "", assert.AnError)
+			},
+			args:        []string{"resolve", "version", "main"},
+			expectedErr: errors.New("failed to get predefined arg values: assert.AnError general error for testing"),
+		},
+		"resolve with error on enhance": {
+			preconditions: func(t *testController) {
+				t.predefinedArgService.EXPECT().GetArgValues("version", "main").Return([]string{"${{env}}"}, "*", nil)
+				t.flagParserService.EXPECT().ParseDynamicFlags([]string{}).Return(entity.NewFlags(), nil)
+				t.configService.EXPECT().GetConfig().Return(application)
+				t.enhanceArgService.EXPECT().EnhanceArgs(gomock.Any()).Return(nil, assert.AnError)
+			},
+			args:        []string{"resolve", "version", "main"},
+			expectedErr: errors.New("failed to resolve values: assert.AnError general error for testing"),
+		},
+		"resolve without key": {
+			args:        []string{"resolve", "version"},
+			expectedErr: errors.New("usage: args resolve <predefined arg> <key> [--operation <operation>] [flags]"),
+		},
+		"without subcommand": {
+			expectedErr: errors.New("subcommand is required: list, resolve"),
+		},
+		"unknown subcommand": {
+			args:        []string{"show"},
+			expectedErr: errors.New("unknown subcommand show, expected: list, resolve"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			err := controller.Build().Run(context.Background(), testCase.args)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedOutput, controller.output.String())
+			}
+		})
+	}
+}
+
+type testController struct {
+	configService        *mocks.MockConfigService
+	sourceService        *mocks.MockSourceService
+	predefinedArgService *mocks.MockPredefinedArgService
+	flagParserService    *mocks.MockFlagParserService
+	enhanceArgService    *mocks.MockEnhanceArgService
+	output               *bytes.Buffer
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService:        mocks.NewMockConfigService(ctrl),
+		sourceService:        mocks.NewMockSourceService(ctrl),
+		predefinedArgService: mocks.NewMockPredefinedArgService(ctrl),
+		flagParserService:    mocks.NewMockFlagParserService(ctrl),
+		enhanceArgService:    mocks.NewMockEnhanceArgService(ctrl),
+		output:               &bytes.Buffer{},
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(
+		t.configService,
+		t.sourceService,
+		t.predefinedArgService,
+		reference.NewService(extractor.NewService()),
+		t.flagParserService,
+		t.enhanceArgService,
+		t.output,
+	)
+}
@@ -0,0 +1,320 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	config "project-helper/internal/config"
+	entity "project-helper/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigService is a mock of ConfigService interface.
+type MockConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigServiceMockRecorder
+}
+
+// MockConfigServiceMockRecorder is the mock recorder for MockConfigService.
+type MockConfigServiceMockRecorder struct {
+	mock *MockConfigService
+}
+
+// NewMockConfigService creates a new mock instance.
+func NewMockConfigService(ctrl *gomock.Controller) *MockConfigService {
+	mock := &MockConfigService{ctrl: ctrl}
+	mock.recorder = &MockConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigService) EXPECT() *MockConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// GetConfig mocks base method.
+func (m *MockConfigService) GetConfig() *config.Application {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*config.Application)
+	return ret0
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockConfigServiceMockRecorder) GetConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockConfigService)(nil).GetConfig))
+}
+
+// GetFiles mocks base method.
+func (m *MockConfigService) GetFiles() []config.File {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiles")
+	ret0, _ := ret[0].([]config.File)
+	return ret0
+}
+
+// GetFiles indicates an expected call of GetFiles.
+func (mr *MockConfigServiceMockRecorder) GetFiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiles", reflect.TypeOf((*MockConfigService)(nil).GetFiles))
+}
+
+// GetPosition mocks base method.
+func (m *MockConfigService) GetPosition(path string) config.Position {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosition", path)
+	ret0, _ := ret[0].(config.Position)
+	return ret0
+}
+
+// GetPosition indicates an expected call of GetPosition.
+func (mr *MockConfigServiceMockRecorder) GetPosition(path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockConfigService)(nil).GetPosition), path)
+}
+
+// MockExtractorService is a mock of ExtractorService interface.
+type MockExtractorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtractorServiceMockRecorder
+}
+
+// MockExtractorServiceMockRecorder is the mock recorder for MockExtractorService.
+type MockExtractorServiceMockRecorder struct {
+	mock *MockExtractorService
+}
+
+// NewMockExtractorService creates a new mock instance.
+func NewMockExtractorService(ctrl *gomock.Controller) *MockExtractorService {
+	mock := &MockExtractorService{ctrl: ctrl}
+	mock.recorder = &MockExtractorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtractorService) EXPECT() *MockExtractorServiceMockRecorder {
+	return m.recorder
+}
+
+// ExtractTags mocks base method.
+func (m *MockExtractorService) ExtractTags(arg entity.Arg) entity.Tags {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTags", arg)
+	ret0, _ := ret[0].(entity.Tags)
+	return ret0
+}
+
+// ExtractTags indicates an expected call of ExtractTags.
+func (mr *MockExtractorServiceMockRecorder) ExtractTags(arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTags", reflect.TypeOf((*MockExtractorService)(nil).ExtractTags), arg)
+}
+
+// ParseTag mocks base method.
+func (m *MockExtractorService) ParseTag(tag entity.Tag) (*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTag", tag)
+	ret0, _ := ret[0].(*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTag indicates an expected call of ParseTag.
+func (mr *MockExtractorServiceMockRecorder) ParseTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTag", reflect.TypeOf((*MockExtractorService)(nil).ParseTag), tag)
+}
+
+// MockReferenceService is a mock of ReferenceService interface.
+type MockReferenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferenceServiceMockRecorder
+}
+
+// MockReferenceServiceMockRecorder is the mock recorder for MockReferenceService.
+type MockReferenceServiceMockRecorder struct {
+	mock *MockReferenceService
+}
+
+// NewMockReferenceService creates a new mock instance.
+func NewMockReferenceService(ctrl *gomock.Controller) *MockReferenceService {
+	mock := &MockReferenceService{ctrl: ctrl}
+	mock.recorder = &MockReferenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferenceService) EXPECT() *MockReferenceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOperationTags mocks base method.
+func (m *MockReferenceService) GetOperationTags(application *config.Application, operation config.Operation) ([]*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationTags", application, operation)
+	ret0, _ := ret[0].([]*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationTags indicates an expected call of GetOperationTags.
+func (mr *MockReferenceServiceMockRecorder) GetOperationTags(application, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationTags", reflect.TypeOf((*MockReferenceService)(nil).GetOperationTags), application, operation)
+}
+
+// MockSourceService is a mock of SourceService interface.
+type MockSourceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceServiceMockRecorder
+}
+
+// MockSourceServiceMockRecorder is the mock recorder for MockSourceService.
+type MockSourceServiceMockRecorder struct {
+	mock *MockSourceService
+}
+
+// NewMockSourceService creates a new mock instance.
+func NewMockSourceService(ctrl *gomock.Controller) *MockSourceService {
+	mock := &MockSourceService{ctrl: ctrl}
+	mock.recorder = &MockSourceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceService) EXPECT() *MockSourceServiceMockRecorder {
+	return m.recorder
+}
+
+// ValidateSources mocks base method.
+func (m *MockSourceService) ValidateSources() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSources")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSources indicates an expected call of ValidateSources.
+func (mr *MockSourceServiceMockRecorder) ValidateSources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSources", reflect.TypeOf((*MockSourceService)(nil).ValidateSources))
+}
+
+// MockPredefinedArgService is a mock of PredefinedArgService interface.
+type MockPredefinedArgService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPredefinedArgServiceMockRecorder
+}
+
+// MockPredefinedArgServiceMockRecorder is the mock recorder for MockPredefinedArgService.
+type MockPredefinedArgServiceMockRecorder struct {
+	mock *MockPredefinedArgService
+}
+
+// NewMockPredefinedArgService creates a new mock instance.
+func NewMockPredefinedArgService(ctrl *gomock.Controller) *MockPredefinedArgService {
+	mock := &MockPredefinedArgService{ctrl: ctrl}
+	mock.recorder = &MockPredefinedArgServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPredefinedArgService) EXPECT() *MockPredefinedArgServiceMockRecorder {
+	return m.recorder
+}
+
+// ValidateTypes mocks base method.
+func (m *MockPredefinedArgService) ValidateTypes() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateTypes")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateTypes indicates an expected call of ValidateTypes.
+func (mr *MockPredefinedArgServiceMockRecorder) ValidateTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTypes", reflect.TypeOf((*MockPredefinedArgService)(nil).ValidateTypes))
+}
+
+// MockVarService is a mock of VarService interface.
+type MockVarService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVarServiceMockRecorder
+}
+
+// MockVarServiceMockRecorder is the mock recorder for MockVarService.
+type MockVarServiceMockRecorder struct {
+	mock *MockVarService
+}
+
+// NewMockVarService creates a new mock instance.
+func NewMockVarService(ctrl *gomock.Controller) *MockVarService {
+	mock := &MockVarService{ctrl: ctrl}
+	mock.recorder = &MockVarServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVarService) EXPECT() *MockVarServiceMockRecorder {
+	return m.recorder
+}
+
+// ValidateVars mocks base method.
+func (m *MockVarService) ValidateVars() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateVars")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateVars indicates an expected call of ValidateVars.
+func (mr *MockVarServiceMockRecorder) ValidateVars() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateVars", reflect.TypeOf((*MockVarService)(nil).ValidateVars))
+}
+
+// MockEnhanceArgService is a mock of EnhanceArgService interface.
+type MockEnhanceArgService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnhanceArgServiceMockRecorder
+}
+
+// MockEnhanceArgServiceMockRecorder is the mock recorder for MockEnhanceArgService.
+type MockEnhanceArgServiceMockRecorder struct {
+	mock *MockEnhanceArgService
+}
+
+// NewMockEnhanceArgService creates a new mock instance.
+func NewMockEnhanceArgService(ctrl *gomock.Controller) *MockEnhanceArgService {
+	mock := &MockEnhanceArgService{ctrl: ctrl}
+	mock.recorder = &MockEnhanceArgServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnhanceArgService) EXPECT() *MockEnhanceArgServiceMockRecorder {
+	return m.recorder
+}
+
+// ValidateArgs mocks base method.
+func (m *MockEnhanceArgService) ValidateArgs(values []config.TaggedValue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateArgs", values)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateArgs indicates an expected call of ValidateArgs.
+func (mr *MockEnhanceArgServiceMockRecorder) ValidateArgs(values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateArgs", reflect.TypeOf((*MockEnhanceArgService)(nil).ValidateArgs), values)
+}
@@ -0,0 +1,195 @@
+package config
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+)
+
+type (
+	ConfigService interface {
+		GetConfig() *config.Application
+		GetPosition(path string) config.Position
+		GetFiles() []config.File
+	}
+	ExtractorService interface {
+		ExtractTags(arg entity.Arg) entity.Tags
+		ParseTag(tag entity.Tag) (*entity.TagExpression, error)
+	}
+	ReferenceService interface {
+		GetOperationTags(application *config.Application, operation config.Operation) ([]*entity.TagExpression, error)
+	}
+	SourceService interface {
+		ValidateSources() error
+	}
+	PredefinedArgService interface {
+		ValidateTypes() error
+	}
+	VarService interface {
+		ValidateVars() error
+	}
+	EnhanceArgService interface {
+		ValidateArgs(values []config.TaggedValue) error
+	}
+)
+
+const (
+	textFormat = "text"
+	jsonFormat = "json"
+)
+
+type Service struct {
+	configService        ConfigService
+	extractorService     ExtractorService
+	referenceService     ReferenceService
+	sourceService        SourceService
+	predefinedArgService PredefinedArgService
+	varService           VarService
+	enhanceArgService    EnhanceArgService
+	writer               io.Writer
+}
+
+func NewService(
+	configService ConfigService,
+	extractorService ExtractorService,
+	referenceService ReferenceService,
+	sourceService SourceService,
+	predefinedArgService PredefinedArgService,
+	varService VarService,
+	enhanceArgService EnhanceArgService,
+	writer io.Writer,
+) *Service {
+	return &Service{
+		configService:        configService,
+		extractorService:     extractorService,
+		referenceService:     referenceService,
+		sourceService:        sourceService,
+		predefinedArgService: predefinedArgService,
+		varService:           varService,
+		enhanceArgService:    enhanceArgService,
+		writer:               writer,
+	}
+}
+
+func (s *Service) Run(_ context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("subcommand is required: validate, schema, where")
+	}
+
+	switch args[0] {
+	case "validate":
+		return s.validate(args[1:])
+	case "schema":
+		return s.schema()
+	case "where":
+		return s.where()
+	default:
+		return errors.Errorf("unknown subcommand %s, expected: validate, schema, where", args[0])
+	}
+}
+
+func (s *Service) schema() error {
+	schema, err := config.MarshalSchema()
+	if err != nil {
+		return errors.Wrap(err, "failed to generate schema")
+	}
+
+	if _, err = s.writer.Write(schema); err != nil {
+		return errors.Wrap(err, "failed to write schema")
+	}
+
+	return nil
+}
+
+// where prints the loaded config files in the order they are layered.
+func (s *Service) where() error {
+	for _, file := range s.configService.GetFiles() {
+		if _, err := fmt.Fprintf(s.writer, "%s: %s\n", file.Scope, file.Path); err != nil {
+			return errors.Wrap(err, "failed to write config files")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) validate(args []string) error {
+	var (
+		format string
+		strict bool
+	)
+
+	flagSet := pflag.NewFlagSet("validate", pflag.ContinueOnError)
+	flagSet.StringVar(&format, "format", textFormat, "Output format: text or json")
+	flagSet.BoolVar(&strict, "strict", false, "Fail on warnings too")
+
+	if err := flagSet.Parse(args); err != nil {
+		return errors.Wrap(err, "failed to parse flags")
+	}
+
+	problems := s.Validate()
+
+	var err error
+
+	switch format {
+	case textFormat:
+		err = s.writeText(problems)
+	case jsonFormat:
+		err = s.writeJSON(problems)
+	default:
+		return errors.Errorf("unknown format %s, expected: text, json", format)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "failed to write problems")
+	}
+
+	failed := 0
+	for _, problem := range problems {
+		if problem.Severity == errorSeverity || strict {
+			failed++
+		}
+	}
+
+	if failed != 0 {
+		return errors.Errorf("config has %d problems", failed)
+	}
+
+	return nil
+}
+
+func (s *Service) writeText(problems []Problem) error {
+	if len(problems) == 0 {
+		_, err := fmt.Fprintln(s.writer, "config is valid")
+
+		return err
+	}
+
+	for _, problem := range problems {
+		position := config.Position{File: problem.File, Line: problem.Line, Column: problem.Column}
+
+		if _, err := fmt.Fprintf(s.writer, "%s: %s: %s: %s\n", position, problem.Severity, problem.Path, problem.Message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) writeJSON(problems []Problem) error {
+	if problems == nil {
+		problems = []Problem{}
+	}
+
+	encoder := json.NewEncoder(s.writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(problems)
+}
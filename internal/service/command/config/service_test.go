@@ -0,0 +1,317 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/service/command/config/mocks"
+	"project-helper/internal/service/tag/extractor"
+	"project-helper/internal/service/tag/reference"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	validConfig := &config.Application{
+		DynamicFlags: config.DynamicFlags{
+			{Name: "env", ShortName: "e", Type: entity.String},
+			{Name: "label", Type: entity.Map},
+		},
+		PredefinedArgs: config.PredefinedArgs{
+			{Name: "env", Args: config.Args{{Name: "feature/*", Values: []string{"${{match.1}}"}}}},
+		},
+		Vars: config.Vars{
+			{Name: "image", Value: "app:${{git.short-sha}}"},
+		},
+		Operations: config.Operations{
+			{
+				Name:              "deploy",
+				ShortName:         "d",
+				PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env", Value: "env"},
+				Args:              []string{"${{image}}", "${{label.team}}", "${{env:HOME}}", "${{tmpdir:build}}"},
+				RunBefore:         config.Operations{{Name: "build", PredefinedFlags: config.PredefinedFlags{{Name: "env"}}}},
+			},
+			{
+				Name:    "build",
+				RawArgs: true,
+				Args:    []string{"${{unknown}}"},
+			},
+		},
+	}
+
+	invalidConfig := &config.Application{
+		DynamicFlags: config.DynamicFlags{
+			{Name: "env", ShortName: "o", Type: entity.String},
+			{Name: "env", Type: entity.String},
+			{Name: "no-input", Type: entity.String},
+		},
+		PredefinedArgs: config.PredefinedArgs{
+			{Name: "dead"},
+		},
+		Operations: config.Operations{
+			{
+				Name:              "deploy",
+				PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env+region", Value: "missing"},
+				Args:              []string{"${{env}}", "${{nope}}", "${{match.1}}", "${{nope | default \"x\"}}"},
+				RunBefore:         config.Operations{{Name: "build"}},
+			},
+			{
+				Name: "deploy",
+			},
+		},
+	}
+
+	schema, err := config.MarshalSchema()
+	require.NoError(t, err)
+
+	positions := config.Positions{
+		"operations[deploy]":                  {File: "app.yaml", Line: 12, Column: 5},
+		"operations[deploy].runBefore[build]": {File: "app.yaml", Line: 16, Column: 9},
+	}
+
+	expectPositions := func(t *testController) {
+		t.configService.EXPECT().GetPosition(gomock.Any()).DoAndReturn(func(path string) config.Position {
+			if position, ok := positions.Get(path); ok {
+				return position
+			}
+
+			return config.Position{File: "app.yaml"}
+		}).AnyTimes()
+	}
+
+	expectValidators := func(t *testController) {
+		expectPositions(t)
+		t.sourceService.EXPECT().ValidateSources().Return(nil)
+		t.predefinedArgService.EXPECT().ValidateTypes().Return(nil)
+		t.varService.EXPECT().ValidateVars().Return(nil)
+		t.enhanceArgService.EXPECT().ValidateArgs(gomock.Any()).Return(nil)
+	}
+
+	tests := map[string]struct {
+		preconditions  func(*testController)
+		args           []string
+		expectedOutput string
+		expectedErr    error
+	}{
+		"valid config": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(validConfig)
+				expectValidators(t)
+			},
+			args:           []string{"validate"},
+			expectedOutput: "config is valid\n",
+		},
+		"valid config as json": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(validConfig)
+				expectValidators(t)
+			},
+			args:           []string{"validate", "--format", "json"},
+			expectedOutput: "[]\n",
+		},
+		"invalid config": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(invalidConfig)
+				expectValidators(t)
+			},
+			args: []string{"validate"},
+			expectedOutput: "app.yaml:12:5: error: operations[deploy]: name deploy is already used by operation deploy\n" +
+				"app.yaml: error: dynamicFlags[env]: name env is already used by flag env\n" +
+				"app.yaml: error: dynamicFlags[env]: shortName -o is reserved for --operation\n" +
+				"app.yaml: error: dynamicFlags[no-input]: name --no-input is reserved\n" +
+				"app.yaml:16:9: error: operations[deploy].runBefore[build]: runBefore operation build does not exist\n" +
+				"app.yaml:12:5: error: operations[deploy]: predefinedArgsTag uses unknown predefinedArgs table missing\n" +
+				"app.yaml:12:5: error: operations[deploy]: predefinedArgsTag uses unknown flag region\n" +
+				"app.yaml:12:5: error: operations[deploy].args[1]: tag ${{nope}} is not a flag, var or built-in tag\n" +
+				"app.yaml:12:5: error: operations[deploy].args[2]: tag ${{match.1}} is not a flag, var or built-in tag\n" +
+				"app.yaml: warning: dynamicFlags[no-input]: flag is not used by any operation, var or predefinedArgs table\n" +
+				"app.yaml: warning: predefinedArgs[dead]: table is not used by any predefinedArgsTag or tag\n",
+			expectedErr: errors.New("config has 9 problems"),
+		},
+		"warnings only": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					PredefinedArgs: config.PredefinedArgs{{Name: "dead"}},
+				})
+				expectValidators(t)
+			},
+			args:           []string{"validate"},
+			expectedOutput: "app.yaml: warning: predefinedArgs[dead]: table is not used by any predefinedArgsTag or tag\n",
+		},
+		"warnings only in strict mode": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					PredefinedArgs: config.PredefinedArgs{{Name: "dead"}},
+				})
+				expectValidators(t)
+			},
+			args: []string{"validate", "--strict", "--format=json"},
+			expectedOutput: "[\n" +
+				"  {\n" +
+				"    \"severity\": \"warning\",\n" +
+				"    \"file\": \"app.yaml\",\n" +
+				"    \"path\": \"predefinedArgs[dead]\",\n" +
+				"    \"message\": \"table is not used by any predefinedArgsTag or tag\"\n" +
+				"  }\n" +
+				"]\n",
+			expectedErr: errors.New("config has 1 problems"),
+		},
+		"with errors of validators": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{})
+				expectPositions(t)
+				t.sourceService.EXPECT().ValidateSources().Return(errors.New("invalid source"))
+				t.predefinedArgService.EXPECT().ValidateTypes().Return(errors.New("invalid type"))
+				t.varService.EXPECT().ValidateVars().Return(errors.New("invalid var"))
+				t.enhanceArgService.EXPECT().ValidateArgs(gomock.Any()).Return(errors.New("invalid filter"))
+			},
+			args: []string{"validate"},
+			expectedOutput: "app.yaml: error: predefinedArgs: invalid source\n" +
+				"app.yaml: error: predefinedArgs: invalid type\n" +
+				"app.yaml: error: vars: invalid var\n" +
+				"app.yaml: error: tags: invalid filter\n",
+			expectedErr: errors.New("config has 4 problems"),
+		},
+		"with several located errors of validators": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{})
+				expectPositions(t)
+				t.sourceService.EXPECT().ValidateSources().Return(config.Errors{
+					{Path: "predefinedArgs[t1].source", Err: errors.New("exactly one of file, dir and cmd must be set")},
+					{Path: "predefinedArgs[t2].source.ttl", Err: errors.New("ttl must not be negative")},
+				})
+				t.predefinedArgService.EXPECT().ValidateTypes().Return(config.Errors{
+					{Path: "predefinedArgs[t1].type", Err: errors.New("predefined arg t1 has unknown type list")},
+					{Path: "predefinedArgs[t2].type", Err: errors.New("predefined arg t2 has unknown type set")},
+				})
+				t.varService.EXPECT().ValidateVars().Return(config.Errors{
+					{Path: "vars[a]", Err: errors.New("var a is not valid: value and cmd are mutually exclusive")},
+					{Path: "operations[deploy].vars[b].cache", Err: errors.New("var b is not valid: unknown cache forever")},
+				})
+				t.enhanceArgService.EXPECT().ValidateArgs(gomock.Any()).Return(errors.Wrap(config.Errors{
+					{Path: "operations[deploy].args[0]", Err: errors.New("unknown filter first")},
+					{Path: "vars[image].value", Err: errors.New("unknown filter second")},
+				}, "failed to validate"))
+			},
+			args: []string{"validate"},
+			expectedOutput: "app.yaml: error: predefinedArgs[t1].source: exactly one of file, dir and cmd must be set\n" +
+				"app.yaml: error: predefinedArgs[t2].source.ttl: ttl must not be negative\n" +
+				"app.yaml: error: predefinedArgs[t1].type: predefined arg t1 has unknown type list\n" +
+				"app.yaml: error: predefinedArgs[t2].type: predefined arg t2 has unknown type set\n" +
+				"app.yaml: error: vars[a]: var a is not valid: value and cmd are mutually exclusive\n" +
+				"app.yaml:12:5: error: operations[deploy].vars[b].cache: var b is not valid: unknown cache forever\n" +
+				"app.yaml:12:5: error: operations[deploy].args[0]: unknown filter first\n" +
+				"app.yaml: error: vars[image].value: unknown filter second\n",
+			expectedErr: errors.New("config has 8 problems"),
+		},
+		"with operations named like commands": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					Operations: config.Operations{
+						{Name: "config", Cmd: "cat"},
+						{Name: "list-apps", ShortName: "apps", Cmd: "ls", RunBefore: config.Operations{{Name: "build"}}},
+					},
+				})
+				expectValidators(t)
+			},
+			args: []string{"validate"},
+			expectedOutput: "app.yaml: error: operations[config]: name config is reserved for the ph config command\n" +
+				"app.yaml: error: operations[list-apps]: name apps is reserved for the ph apps command\n" +
+				"app.yaml: error: operations[list-apps].runBefore[build]: runBefore operation build does not exist\n",
+			expectedErr: errors.New("config has 3 problems"),
+		},
+		"unknown format": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{})
+				expectValidators(t)
+			},
+			args:        []string{"validate", "--format", "xml"},
+			expectedErr: errors.New("unknown format xml, expected: text, json"),
+		},
+		"schema": {
+			args:           []string{"schema"},
+			expectedOutput: string(schema),
+		},
+		"where": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetFiles().Return([]config.File{
+					{Path: "/home/user/.config/project-helper/application.yaml", Scope: config.GlobalScope},
+					{Path: "/repo/.project-helper.yaml", Scope: config.ProjectScope},
+				})
+			},
+			args: []string{"where"},
+			expectedOutput: "global: /home/user/.config/project-helper/application.yaml\n" +
+				"project: /repo/.project-helper.yaml\n",
+		},
+		"without subcommand": {
+			expectedErr: errors.New("subcommand is required: validate, schema, where"),
+		},
+		"unknown subcommand": {
+			args:        []string{"show"},
+			expectedErr: errors.New("unknown subcommand show, expected: validate, schema, where"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			err := controller.Build().Run(context.Background(), testCase.args)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.expectedOutput, controller.output.String())
+		})
+	}
+}
+
+type testController struct {
+	configService        *mocks.MockConfigService
+	sourceService        *mocks.MockSourceService
+	predefinedArgService *mocks.MockPredefinedArgService
+	varService           *mocks.MockVarService
+	enhanceArgService    *mocks.MockEnhanceArgService
+	output               *bytes.Buffer
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService:        mocks.NewMockConfigService(ctrl),
+		sourceService:        mocks.NewMockSourceService(ctrl),
+		predefinedArgService: mocks.NewMockPredefinedArgService(ctrl),
+		varService:           mocks.NewMockVarService(ctrl),
+		enhanceArgService:    mocks.NewMockEnhanceArgService(ctrl),
+		output:               &bytes.Buffer{},
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(
+		t.configService,
+		extractor.NewService(),
+		reference.NewService(extractor.NewService()),
+		t.sourceService,
+		t.predefinedArgService,
+		t.varService,
+		t.enhanceArgService,
+		t.output,
+	)
+}
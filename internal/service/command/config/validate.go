@@ -0,0 +1,342 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+)
+
+const (
+	errorSeverity   = "error"
+	warningSeverity = "warning"
+)
+
+type Problem struct {
+	Severity string `json:"severity"`
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+}
+
+// tagScope tells which names a tag can reference where it is used.
+type tagScope struct {
+	vars       map[string]config.Var
+	predefined bool
+}
+
+type validation struct {
+	application *config.Application
+	flags       map[string]config.DynamicFlag
+	tables      map[string]config.PredefinedArg
+	problems    []Problem
+}
+
+// Validate checks the whole config and returns every problem found, errors
+// first in config order and then warnings about unused entries.
+func (s *Service) Validate() []Problem {
+	application := s.configService.GetConfig()
+
+	v := &validation{
+		application: application,
+		flags:       make(map[string]config.DynamicFlag, len(application.DynamicFlags)),
+		tables:      application.GetPredefinedArgs(),
+	}
+
+	for _, dynamicFlag := range application.DynamicFlags {
+		v.flags[dynamicFlag.Name] = dynamicFlag
+	}
+
+	v.checkDuplicates()
+	v.addErrors("dynamicFlags", application.ValidateFlagNames())
+	v.addErrors("operations", application.ValidateOperationNames())
+	v.checkOperations()
+	s.checkTags(v)
+
+	v.addErrors("predefinedArgs", s.sourceService.ValidateSources())
+	v.addErrors("predefinedArgs", s.predefinedArgService.ValidateTypes())
+	v.addErrors("vars", s.varService.ValidateVars())
+	v.addErrors("tags", s.enhanceArgService.ValidateArgs(application.GetTaggedValues()))
+
+	s.checkUnused(v)
+
+	for i, problem := range v.problems {
+		position := s.configService.GetPosition(problem.Path)
+
+		v.problems[i].File, v.problems[i].Line, v.problems[i].Column = position.File, position.Line, position.Column
+	}
+
+	return v.problems
+}
+
+func (v *validation) checkDuplicates() {
+	operations := make(map[string]string)
+
+	for _, operation := range v.application.Operations {
+		for _, name := range []string{operation.Name, operation.ShortName} {
+			if name == "" {
+				continue
+			}
+
+			if owner, ok := operations[name]; ok {
+				v.addError(operationPath(operation), fmt.Sprintf("name %s is already used by operation %s", name, owner))
+
+				continue
+			}
+
+			operations[name] = operation.Name
+		}
+	}
+
+	dynamicFlags := make(map[string]string)
+
+	for _, dynamicFlag := range v.application.DynamicFlags {
+		for _, name := range []string{dynamicFlag.Name, dynamicFlag.ShortName} {
+			if name == "" {
+				continue
+			}
+
+			if owner, ok := dynamicFlags[name]; ok {
+				v.addError(path("dynamicFlags", dynamicFlag.Name), fmt.Sprintf("name %s is already used by flag %s", name, owner))
+
+				continue
+			}
+
+			dynamicFlags[name] = dynamicFlag.Name
+		}
+	}
+
+	predefinedArgs := make(map[string]bool)
+
+	for _, predefinedArg := range v.application.PredefinedArgs {
+		if predefinedArgs[predefinedArg.Name] {
+			v.addError(path("predefinedArgs", predefinedArg.Name), "name is already used by another predefinedArgs table")
+		}
+
+		predefinedArgs[predefinedArg.Name] = true
+	}
+
+	v.checkDuplicateVars("vars", v.application.Vars)
+
+	for _, operation := range v.application.Operations {
+		v.checkDuplicateVars(operationPath(operation)+".vars", operation.Vars)
+	}
+}
+
+func (v *validation) checkDuplicateVars(prefix string, vars config.Vars) {
+	names := make(map[string]bool, len(vars))
+
+	for _, variable := range vars {
+		if names[variable.Name] {
+			v.addError(fmt.Sprintf("%s[%s]", prefix, variable.Name), "name is already used by another var")
+		}
+
+		names[variable.Name] = true
+	}
+}
+
+func (v *validation) checkOperations() {
+	operations := v.application.GetOperationsMap()
+
+	for _, operation := range v.application.Operations {
+		for _, beforeOperation := range operation.RunBefore {
+			if _, ok := operations[beforeOperation.Name]; !ok {
+				v.addError(fmt.Sprintf("%s.runBefore[%s]", operationPath(operation), beforeOperation.Name),
+					fmt.Sprintf("runBefore operation %s does not exist", beforeOperation.Name))
+			}
+
+			for _, predefinedFlag := range beforeOperation.PredefinedFlags {
+				if _, ok := v.flags[predefinedFlag.Name]; !ok {
+					v.addError(operationPath(operation), fmt.Sprintf("predefinedFlags of %s use unknown flag %s", beforeOperation.Name, predefinedFlag.Name))
+				}
+			}
+		}
+
+		for _, predefinedArgsTag := range operation.GetPredefinedArgsTags() {
+			if _, ok := v.tables[predefinedArgsTag.Value]; !ok {
+				v.addError(operationPath(operation), fmt.Sprintf("predefinedArgsTag uses unknown predefinedArgs table %s", predefinedArgsTag.Value))
+			}
+
+			for _, flagName := range predefinedArgsTag.GetFlagNames() {
+				if _, ok := v.flags[flagName]; !ok {
+					v.addError(operationPath(operation), fmt.Sprintf("predefinedArgsTag uses unknown flag %s", flagName))
+				}
+			}
+		}
+	}
+}
+
+func (s *Service) checkTags(v *validation) {
+	allVars := v.application.GetVars(config.Operation{})
+	for _, operation := range v.application.Operations {
+		for name, variable := range v.application.GetVars(operation) {
+			allVars[name] = variable
+		}
+	}
+
+	for _, operation := range v.application.Operations {
+		s.checkValues(v, (&config.Application{Operations: config.Operations{operation}}).GetTaggedValues(), tagScope{vars: v.application.GetVars(operation)})
+	}
+
+	s.checkValues(v, v.application.Vars.GetTaggedValues("vars"), tagScope{vars: allVars})
+	s.checkValues(v, (&config.Application{PredefinedArgs: v.application.PredefinedArgs}).GetTaggedValues(), tagScope{vars: allVars, predefined: true})
+}
+
+func (s *Service) checkValues(v *validation, values []config.TaggedValue, scope tagScope) {
+	for _, value := range values {
+		for _, tag := range s.extractorService.ExtractTags(entity.Arg(value.Value)) {
+			tagExpression, err := s.extractorService.ParseTag(tag)
+			if err != nil {
+				v.addError(value.Path, err.Error())
+
+				continue
+			}
+
+			if _, ok := tagExpression.Filters.GetDefault(); ok || v.canResolve(tagExpression.Name, scope) {
+				continue
+			}
+
+			v.addError(value.Path, fmt.Sprintf("tag %s is not a flag, var or built-in tag", tag))
+		}
+	}
+}
+
+func (v *validation) canResolve(name string, scope tagScope) bool {
+	if flagName, ok := strings.CutPrefix(name, entity.FlagTagNamespace+"."); ok {
+		return v.isFlag(flagName)
+	}
+
+	if varName, ok := strings.CutPrefix(name, entity.VarTagNamespace+"."); ok {
+		_, ok = scope.vars[varName]
+
+		return ok
+	}
+
+	if gitName, ok := strings.CutPrefix(name, entity.GitTagNamespace+"."); ok {
+		return slices.Contains(entity.BuiltinTags, entity.GitTagNamespace+"-"+gitName)
+	}
+
+	for _, namespace := range []string{
+		entity.EnvTagNamespace, entity.SecretTagNamespace, entity.FileTagNamespace, entity.JSONTagNamespace, entity.YAMLTagNamespace,
+	} {
+		if strings.HasPrefix(name, namespace+".") || strings.HasPrefix(name, namespace+":") {
+			return true
+		}
+	}
+
+	if strings.HasPrefix(name, entity.MatchTagNamespace+".") {
+		return scope.predefined
+	}
+
+	if _, ok := scope.vars[name]; ok {
+		return true
+	}
+
+	resourceName, _, _ := strings.Cut(name, ":")
+
+	return v.isFlag(name) || slices.Contains(entity.BuiltinTags, name) || slices.Contains(entity.BuiltinTags, resourceName)
+}
+
+// isFlag accepts a dynamic flag name or a key of a map flag.
+func (v *validation) isFlag(name string) bool {
+	if _, ok := v.flags[name]; ok {
+		return true
+	}
+
+	flagName, _, ok := strings.Cut(name, ".")
+	if !ok {
+		return false
+	}
+
+	dynamicFlag, ok := v.flags[flagName]
+
+	return ok && dynamicFlag.Type == entity.Map
+}
+
+// checkUnused warns about flags and tables no operation reaches, walking the
+// references the same way ph args list does.
+func (s *Service) checkUnused(v *validation) {
+	usedFlags := make(map[string]bool)
+	usedTables := make(map[string]bool)
+
+	for _, operation := range v.application.Operations {
+		for _, predefinedArgsTag := range operation.GetPredefinedArgsTags() {
+			usedTables[predefinedArgsTag.Value] = true
+
+			for _, flagName := range predefinedArgsTag.GetFlagNames() {
+				usedFlags[flagName] = true
+			}
+		}
+
+		for _, beforeOperation := range operation.RunBefore {
+			for _, predefinedFlag := range beforeOperation.PredefinedFlags {
+				usedFlags[predefinedFlag.Name] = true
+			}
+		}
+
+		// invalid tags are reported by checkTags
+		tags, _ := s.referenceService.GetOperationTags(v.application, operation)
+
+		for _, tagExpression := range tags {
+			usedTables[tagExpression.Name] = true
+
+			name := strings.TrimPrefix(tagExpression.Name, entity.FlagTagNamespace+".")
+			flagName, _, _ := strings.Cut(name, ".")
+
+			usedFlags[name] = true
+			usedFlags[flagName] = true
+		}
+	}
+
+	for _, dynamicFlag := range v.application.DynamicFlags {
+		if !usedFlags[dynamicFlag.Name] {
+			v.addWarning(path("dynamicFlags", dynamicFlag.Name), "flag is not used by any operation, var or predefinedArgs table")
+		}
+	}
+
+	for _, predefinedArg := range v.application.PredefinedArgs {
+		if !usedTables[predefinedArg.Name] {
+			v.addWarning(path("predefinedArgs", predefinedArg.Name), "table is not used by any predefinedArgsTag or tag")
+		}
+	}
+}
+
+func (v *validation) addError(path string, message string) {
+	v.problems = append(v.problems, Problem{Severity: errorSeverity, Path: path, Message: message})
+}
+
+// addErrors reports every config.Error of a validator at its own path, other
+// errors at the section.
+func (v *validation) addErrors(section string, err error) {
+	if err == nil {
+		return
+	}
+
+	var configErrors config.Errors
+	if errors.As(err, &configErrors) {
+		for _, configError := range configErrors {
+			v.addError(configError.Path, configError.Err.Error())
+		}
+
+		return
+	}
+
+	v.addError(section, err.Error())
+}
+
+func (v *validation) addWarning(path string, message string) {
+	v.problems = append(v.problems, Problem{Severity: warningSeverity, Path: path, Message: message})
+}
+
+func operationPath(operation config.Operation) string {
+	return path("operations", operation.Name)
+}
+
+func path(section string, name string) string {
+	return fmt.Sprintf("%s[%s]", section, name)
+}
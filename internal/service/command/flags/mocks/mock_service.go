@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStateService is a mock of StateService interface.
+type MockStateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateServiceMockRecorder
+}
+
+// MockStateServiceMockRecorder is the mock recorder for MockStateService.
+type MockStateServiceMockRecorder struct {
+	mock *MockStateService
+}
+
+// NewMockStateService creates a new mock instance.
+func NewMockStateService(ctrl *gomock.Controller) *MockStateService {
+	mock := &MockStateService{ctrl: ctrl}
+	mock.recorder = &MockStateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateService) EXPECT() *MockStateServiceMockRecorder {
+	return m.recorder
+}
+
+// GetStickyFlags mocks base method.
+func (m *MockStateService) GetStickyFlags() (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStickyFlags")
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStickyFlags indicates an expected call of GetStickyFlags.
+func (mr *MockStateServiceMockRecorder) GetStickyFlags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStickyFlags", reflect.TypeOf((*MockStateService)(nil).GetStickyFlags))
+}
+
+// ResetStickyFlags mocks base method.
+func (m *MockStateService) ResetStickyFlags(names ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range names {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetStickyFlags", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetStickyFlags indicates an expected call of ResetStickyFlags.
+func (mr *MockStateServiceMockRecorder) ResetStickyFlags(names ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetStickyFlags", reflect.TypeOf((*MockStateService)(nil).ResetStickyFlags), names...)
+}
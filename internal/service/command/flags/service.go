@@ -0,0 +1,83 @@
+package flags
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	StateService interface {
+		GetStickyFlags() (map[string][]string, error)
+		ResetStickyFlags(names ...string) error
+	}
+)
+
+type Service struct {
+	stateService StateService
+	writer       io.Writer
+}
+
+func NewService(stateService StateService, writer io.Writer) *Service {
+	return &Service{
+		stateService: stateService,
+		writer:       writer,
+	}
+}
+
+func (s *Service) Run(_ context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("subcommand is required: show, reset")
+	}
+
+	switch args[0] {
+	case "show":
+		return s.show()
+	case "reset":
+		return s.reset(args[1:])
+	default:
+		return errors.Errorf("unknown subcommand %s, expected: show, reset", args[0])
+	}
+}
+
+func (s *Service) show() error {
+	stickyFlags, err := s.stateService.GetStickyFlags()
+	if err != nil {
+		return errors.Wrap(err, "failed to get sticky flags")
+	}
+
+	if len(stickyFlags) == 0 {
+		_, err = fmt.Fprintln(s.writer, "no sticky flags stored")
+
+		return err
+	}
+
+	names := make([]string, 0, len(stickyFlags))
+	for name := range stickyFlags {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, err = fmt.Fprintf(s.writer, "%s=%s\n", name, strings.Join(stickyFlags[name], ",")); err != nil {
+			return errors.Wrap(err, "failed to write sticky flag")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) reset(names []string) error {
+	if err := s.stateService.ResetStickyFlags(names...); err != nil {
+		return errors.Wrap(err, "failed to reset sticky flags")
+	}
+
+	return nil
+}
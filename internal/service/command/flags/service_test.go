@@ -0,0 +1,113 @@
+package flags
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/service/command/flags/mocks"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		preconditions  func(*testController)
+		args           []string
+		expectedOutput string
+		expectedErr    error
+	}{
+		"show": {
+			preconditions: func(t *testController) {
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{
+					"services": {"api", "web"},
+					"env":      {"prod"},
+				}, nil)
+			},
+			args:           []string{"show"},
+			expectedOutput: "env=prod\nservices=api,web\n",
+		},
+		"show without stored flags": {
+			preconditions: func(t *testController) {
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{}, nil)
+			},
+			args:           []string{"show"},
+			expectedOutput: "no sticky flags stored\n",
+		},
+		"reset": {
+			preconditions: func(t *testController) {
+				t.stateService.EXPECT().ResetStickyFlags().Return(nil)
+			},
+			args: []string{"reset"},
+		},
+		"reset selected flags": {
+			preconditions: func(t *testController) {
+				t.stateService.EXPECT().ResetStickyFlags("env", "services").Return(nil)
+			},
+			args: []string{"reset", "env", "services"},
+		},
+		"with error on show": {
+			preconditions: func(t *testController) {
+				t.stateService.EXPECT().GetStickyFlags().Return(nil, assert.AnError)
+			},
+			args:        []string{"show"},
+			expectedErr: errors.New("failed to get sticky flags: assert.AnError general error for testing"),
+		},
+		"with error on reset": {
+			preconditions: func(t *testController) {
+				t.stateService.EXPECT().ResetStickyFlags().Return(assert.AnError)
+			},
+			args:        []string{"reset"},
+			expectedErr: errors.New("failed to reset sticky flags: assert.AnError general error for testing"),
+		},
+		"without subcommand": {
+			expectedErr: errors.New("subcommand is required: show, reset"),
+		},
+		"unknown subcommand": {
+			args:        []string{"list"},
+			expectedErr: errors.New("unknown subcommand list, expected: show, reset"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			err := controller.Build().Run(context.Background(), testCase.args)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedOutput, controller.output.String())
+			}
+		})
+	}
+}
+
+type testController struct {
+	stateService *mocks.MockStateService
+	output       *bytes.Buffer
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		stateService: mocks.NewMockStateService(ctrl),
+		output:       &bytes.Buffer{},
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(t.stateService, t.output)
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"context"
+)
+
+type (
+	Command interface {
+		Run(ctx context.Context, args []string) error
+	}
+)
+
+type Service struct {
+	commands map[string]Command
+}
+
+func NewService() *Service {
+	return &Service{
+		commands: make(map[string]Command),
+	}
+}
+
+func (s *Service) Register(name string, command Command) {
+	s.commands[name] = command
+}
+
+func (s *Service) Find(args []string) (Command, []string, bool) {
+	if len(args) == 0 {
+		return nil, nil, false
+	}
+
+	command, ok := s.commands[args[0]]
+	if !ok {
+		return nil, nil, false
+	}
+
+	return command, args[1:], true
+}
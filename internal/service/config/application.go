@@ -0,0 +1,303 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+	domainerrors "project-helper/internal/domain/errors"
+)
+
+// ApplicationEnv selects the application when --app is not set.
+const ApplicationEnv = "PH_APP"
+
+type application struct {
+	config    *config.Application
+	positions config.Positions
+	file      string
+}
+
+// loadApplications reads the global config. It is a single application, a
+// list of applications sharing its top-level settings, or a directory with a
+// file per application.
+func (s *Service) loadApplications(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open config file")
+	}
+
+	if info.IsDir() {
+		return s.loadApplicationsDir(path)
+	}
+
+	applicationConfig, positions, err := loadConfig(path)
+	if err != nil {
+		return err
+	}
+
+	// the top-level path keeps resolving against the working directory, only entries use the file's
+	applicationConfig.Path, err = s.pathResolver.ResolvePath(applicationConfig.Path)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve application path")
+	}
+
+	if len(applicationConfig.Applications) == 0 {
+		s.applications = []application{{config: applicationConfig, positions: positions, file: path}}
+
+		return nil
+	}
+
+	base := *applicationConfig
+	base.Applications = nil
+
+	s.base = &application{config: &base, positions: positions, file: path}
+
+	for i := range applicationConfig.Applications {
+		entry := &applicationConfig.Applications[i]
+
+		if entry.Name == "" {
+			return errors.Errorf("%s: applications[%d]: name is required", positions[fmt.Sprintf("applications[%d]", i)], i)
+		}
+
+		prefix := fmt.Sprintf("applications[%s]", entry.Name)
+
+		if err = s.addApplication(entry, getApplicationPositions(positions, prefix), path); err != nil {
+			return errors.Wrapf(err, "%s: %s", positions[prefix], prefix)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) loadApplicationsDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to read config directory")
+	}
+
+	for _, entry := range entries {
+		extension := filepath.Ext(entry.Name())
+		if entry.IsDir() || (extension != ".yaml" && extension != ".yml") {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+
+		applicationConfig, positions, err := loadConfig(path)
+		if err != nil {
+			return err
+		}
+
+		if applicationConfig.Name == "" {
+			applicationConfig.Name = strings.TrimSuffix(entry.Name(), extension)
+		}
+
+		if err = s.addApplication(applicationConfig, positions, path); err != nil {
+			return errors.Wrap(err, path)
+		}
+	}
+
+	if len(s.applications) == 0 {
+		return errors.Errorf("config directory %s has no application files", dir)
+	}
+
+	return nil
+}
+
+func (s *Service) addApplication(applicationConfig *config.Application, positions config.Positions, file string) error {
+	if len(applicationConfig.Applications) != 0 {
+		return errors.New("applications can't be nested")
+	}
+
+	if _, ok := s.getApplication(applicationConfig.Name); ok {
+		return errors.Errorf("application %s is defined twice", applicationConfig.Name)
+	}
+
+	var err error
+
+	applicationConfig.Path, err = s.resolveConfigPath(file, applicationConfig.Path)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve application path")
+	}
+
+	s.applications = append(s.applications, application{config: applicationConfig, positions: positions, file: file})
+
+	return nil
+}
+
+// getApplicationPositions returns the positions of an entry of applications
+// relative to the entry, so they match the paths of the selected config.
+func getApplicationPositions(positions config.Positions, prefix string) config.Positions {
+	applicationPositions := make(config.Positions)
+
+	for path, position := range positions {
+		if relativePath, ok := strings.CutPrefix(path, prefix+"."); ok {
+			applicationPositions[relativePath] = position
+		}
+	}
+
+	return applicationPositions
+}
+
+// SelectApplication selects the application named by --app, then by PH_APP,
+// then the one containing the working directory, then the only one. Without
+// applications the project config alone is used.
+func (s *Service) SelectApplication(name string) error {
+	if name == "" {
+		name = s.applicationEnv
+	}
+
+	if name != "" {
+		selected, ok := s.getApplication(name)
+		if !ok {
+			return errors.Wrapf(
+				domainerrors.ErrorApplicationNotFound,
+				"application %s not found, expected one of: %s", name, strings.Join(s.getApplicationNames(), ", "),
+			)
+		}
+
+		return s.selectApplication(selected)
+	}
+
+	if selected, ok := s.findApplicationByPath(s.workingDir); ok {
+		return s.selectApplication(selected)
+	}
+
+	switch {
+	case len(s.applications) == 1:
+		return s.selectApplication(&s.applications[0])
+	case len(s.applications) == 0 || s.projectPath != "":
+		return s.selectApplication(nil)
+	default:
+		return errors.Wrapf(
+			domainerrors.ErrorApplicationNotSelected,
+			"select one of %s with --%s or %s",
+			strings.Join(s.getApplicationNames(), ", "), entity.ApplicationFlag, ApplicationEnv,
+		)
+	}
+}
+
+// selectApplication layers the application over the shared settings and the
+// project config over both. A nil application uses the shared settings only.
+func (s *Service) selectApplication(selected *application) error {
+	s.config, s.positions, s.files, s.selected = nil, make(config.Positions), nil, ""
+
+	if s.base != nil {
+		s.config = s.base.config
+		s.addPositions(s.base.positions)
+		s.files = append(s.files, config.File{Path: s.base.file, Scope: config.GlobalScope})
+	}
+
+	if selected != nil {
+		if s.config != nil {
+			s.config = layerConfig(s.config, selected.config)
+		} else {
+			s.config = selected.config
+		}
+
+		s.addPositions(selected.positions)
+		s.selected = selected.config.Name
+
+		if s.base == nil || s.base.file != selected.file {
+			s.files = append(s.files, config.File{Path: selected.file, Scope: config.GlobalScope})
+		}
+	}
+
+	if s.projectPath != "" {
+		if err := s.layerProjectConfig(s.projectPath); err != nil {
+			return err
+		}
+	}
+
+	if s.config == nil {
+		return errors.Wrap(domainerrors.ErrorApplicationNotSelected, "no application configured")
+	}
+
+	s.predefinedArgs = s.config.GetPredefinedArgs()
+	s.operationsMap = s.config.GetOperationsMap()
+	s.additionalArgs = map[string]string{
+		entity.ApplicationPathTag: s.config.Path,
+	}
+
+	return nil
+}
+
+func (s *Service) addPositions(positions config.Positions) {
+	for path, position := range positions {
+		s.positions[path] = position
+	}
+}
+
+// findApplicationByPath returns the application with the deepest path
+// containing dir.
+func (s *Service) findApplicationByPath(dir string) (*application, bool) {
+	var (
+		found  *application
+		length int
+	)
+
+	for i := range s.applications {
+		path := s.getApplicationPath(s.applications[i])
+		if path == "" || len(path) <= length {
+			continue
+		}
+
+		relativePath, err := filepath.Rel(path, dir)
+		if err != nil || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		found, length = &s.applications[i], len(path)
+	}
+
+	return found, found != nil
+}
+
+func (s *Service) getApplication(name string) (*application, bool) {
+	for i := range s.applications {
+		if s.applications[i].config.Name == name {
+			return &s.applications[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Service) getApplicationPath(entry application) string {
+	if entry.config.Path == "" && s.base != nil {
+		return s.base.config.Path
+	}
+
+	return entry.config.Path
+}
+
+func (s *Service) getApplicationNames() []string {
+	names := make([]string, 0, len(s.applications))
+
+	for _, entry := range s.applications {
+		names = append(names, entry.config.Name)
+	}
+
+	return names
+}
+
+// GetApplications returns the configured applications with their paths.
+func (s *Service) GetApplications() []config.Application {
+	applications := make([]config.Application, 0, len(s.applications))
+
+	for _, entry := range s.applications {
+		applications = append(applications, config.Application{Name: entry.config.Name, Path: s.getApplicationPath(entry)})
+	}
+
+	return applications
+}
+
+// GetApplicationName returns the name of the selected application, empty when
+// the config has none or only the project config is used.
+func (s *Service) GetApplicationName() string {
+	return s.selected
+}
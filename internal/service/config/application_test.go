@@ -0,0 +1,273 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/config"
+	domainerrors "project-helper/internal/domain/errors"
+)
+
+func TestLoadApplications(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	applicationsPath := filepath.Join(dir, "application.yaml")
+	err := os.WriteFile(applicationsPath, []byte(`path: /src
+operations:
+  - name: build
+    cmd: make
+applications:
+  - name: api
+    path: /src/api
+    operations:
+      - name: test
+        cmd: go
+  - name: web
+    operations:
+      - name: build
+        cmd: npm
+`), 0o600)
+	require.NoError(t, err)
+
+	applicationsDir := filepath.Join(dir, "applications")
+	require.NoError(t, os.Mkdir(applicationsDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(applicationsDir, "api.yaml"), []byte("path: /src/api\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(applicationsDir, "web.yml"), []byte("name: frontend\npath: /src/web\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(applicationsDir, "README.md"), nil, 0o600))
+
+	unnamedPath := filepath.Join(dir, "unnamed.yaml")
+	require.NoError(t, os.WriteFile(unnamedPath, []byte("applications:\n  - path: /src\n"), 0o600))
+
+	relativePath := filepath.Join(dir, "relative.yaml")
+	require.NoError(t, os.WriteFile(relativePath, []byte("path: src\napplications:\n  - name: api\n    path: src/api\n  - name: web\n"), 0o600))
+
+	relativeDir := filepath.Join(dir, "relative")
+	require.NoError(t, os.Mkdir(relativeDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(relativeDir, "api.yaml"), []byte("path: ../src/api\n"), 0o600))
+
+	duplicatedPath := filepath.Join(dir, "duplicated.yaml")
+	require.NoError(t, os.WriteFile(duplicatedPath, []byte("applications:\n  - name: api\n  - name: api\n"), 0o600))
+
+	tests := map[string]struct {
+		path                 string
+		expectedApplications []config.Application
+		expectedErr          error
+	}{
+		"applications of a file": {
+			path: applicationsPath,
+			expectedApplications: []config.Application{
+				{Name: "api", Path: "/src/api"},
+				{Name: "web", Path: "/src"},
+			},
+		},
+		"directory of application files": {
+			path: applicationsDir,
+			expectedApplications: []config.Application{
+				{Name: "api", Path: "/src/api"},
+				{Name: "frontend", Path: "/src/web"},
+			},
+		},
+		"relative paths of a file": {
+			path: relativePath,
+			expectedApplications: []config.Application{
+				{Name: "api", Path: filepath.Join(dir, "src/api")},
+				{Name: "web", Path: "src"},
+			},
+		},
+		"relative path of an application file": {
+			path: relativeDir,
+			expectedApplications: []config.Application{
+				{Name: "api", Path: filepath.Join(dir, "src/api")},
+			},
+		},
+		"application without name": {
+			path:        unnamedPath,
+			expectedErr: errors.New("unnamed.yaml:2:5: applications[0]: name is required"),
+		},
+		"duplicated application": {
+			path:        duplicatedPath,
+			expectedErr: errors.New("application api is defined twice"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &Service{pathResolver: newPathResolver(t)}
+
+			err := svc.loadApplications(testCase.path)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedApplications, svc.GetApplications())
+			}
+		})
+	}
+}
+
+func TestSelectApplication(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "application.yaml")
+	err := os.WriteFile(path, []byte(`path: /src
+operations:
+  - name: build
+    cmd: make
+applications:
+  - name: api
+    path: /src/api
+    operations:
+      - name: test
+        cmd: go
+  - name: web
+    operations:
+      - name: build
+        cmd: npm
+`), 0o600)
+	require.NoError(t, err)
+
+	apiConfig := &config.Application{
+		Name: "api",
+		Path: "/src/api",
+		Operations: config.Operations{
+			{Name: "build", Cmd: "make"},
+			{Name: "test", Cmd: "go"},
+		},
+		DynamicFlags:   config.DynamicFlags{},
+		PredefinedArgs: config.PredefinedArgs{},
+		Vars:           config.Vars{},
+	}
+
+	webConfig := &config.Application{
+		Name:           "web",
+		Path:           "/src",
+		Operations:     config.Operations{{Name: "build", Cmd: "npm"}},
+		DynamicFlags:   config.DynamicFlags{},
+		PredefinedArgs: config.PredefinedArgs{},
+		Vars:           config.Vars{},
+	}
+
+	tests := map[string]struct {
+		name             string
+		applicationEnv   string
+		workingDir       string
+		expectedConfig   *config.Application
+		expectedPosition string
+		expectedErr      error
+	}{
+		"application with own path": {
+			name:             "api",
+			workingDir:       "/home/user",
+			expectedConfig:   apiConfig,
+			expectedPosition: path + ":9:9",
+		},
+		"application overriding shared operation": {
+			name:             "web",
+			workingDir:       "/home/user",
+			expectedConfig:   webConfig,
+			expectedPosition: path + ":12:5",
+		},
+		"flag over env and working directory": {
+			name:             "web",
+			applicationEnv:   "gone",
+			workingDir:       "/src/api/cmd",
+			expectedConfig:   webConfig,
+			expectedPosition: path + ":12:5",
+		},
+		"env over working directory": {
+			applicationEnv:   "web",
+			workingDir:       "/src/api/cmd",
+			expectedConfig:   webConfig,
+			expectedPosition: path + ":12:5",
+		},
+		"working directory": {
+			workingDir:       "/src/api/cmd",
+			expectedConfig:   apiConfig,
+			expectedPosition: path + ":9:9",
+		},
+		"unknown application": {
+			name:        "docs",
+			workingDir:  "/home/user",
+			expectedErr: errors.Wrap(domainerrors.ErrorApplicationNotFound, "application docs not found, expected one of: api, web"),
+		},
+		"unknown application in env": {
+			applicationEnv: "gone",
+			workingDir:     "/src/api",
+			expectedErr:    errors.Wrap(domainerrors.ErrorApplicationNotFound, "application gone not found, expected one of: api, web"),
+		},
+		"without selected application": {
+			workingDir:  "/home/user",
+			expectedErr: errors.Wrap(domainerrors.ErrorApplicationNotSelected, "select one of api, web with --app or PH_APP"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &Service{pathResolver: newPathResolver(t), workingDir: testCase.workingDir, applicationEnv: testCase.applicationEnv}
+			require.NoError(t, svc.loadApplications(path))
+
+			err := svc.SelectApplication(testCase.name)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedConfig, svc.GetConfig())
+				assert.Equal(t, testCase.expectedConfig.Name, svc.GetApplicationName())
+				assert.Equal(t, testCase.expectedPosition, svc.GetPosition("operations[test]").String())
+				assert.Equal(t, []config.File{{Path: path, Scope: config.GlobalScope}}, svc.GetFiles())
+			}
+		})
+	}
+}
+
+func TestFindApplicationByPath(t *testing.T) {
+	t.Parallel()
+
+	svc := &Service{
+		base: &application{config: &config.Application{Path: "/src"}},
+		applications: []application{
+			{config: &config.Application{Name: "monorepo"}},
+			{config: &config.Application{Name: "api", Path: "/src/api"}},
+			{config: &config.Application{Name: "api-v2", Path: "/src/api-v2"}},
+		},
+	}
+
+	tests := map[string]struct {
+		dir      string
+		expected string
+	}{
+		"application path":         {dir: "/src/api", expected: "api"},
+		"nested directory":         {dir: "/src/api/cmd/server", expected: "api"},
+		"sibling with same prefix": {dir: "/src/api-v2/cmd", expected: "api-v2"},
+		"shared path":              {dir: "/src/docs", expected: "monorepo"},
+		"outside of applications":  {dir: "/home/user"},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found, ok := svc.findApplicationByPath(testCase.dir)
+
+			assert.Equal(t, testCase.expected != "", ok)
+
+			if ok {
+				assert.Equal(t, testCase.expected, found.config.Name)
+			}
+		})
+	}
+}
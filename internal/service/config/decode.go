@@ -0,0 +1,154 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	"project-helper/internal/config"
+)
+
+var (
+	yamlErrorRegexp = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+	fieldRegexp     = regexp.MustCompile(`^field (\S+) not found`)
+)
+
+// decodeConfig decodes the config strictly, so unknown and repeated fields
+// are errors, and reports every error with its file, line and column.
+func decodeConfig(file string, data []byte) (*config.Application, config.Positions, error) {
+	var document yaml.Node
+
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, nil, locateErrors(file, nil, err)
+	}
+
+	application := &config.Application{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(application); err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, locateErrors(file, &document, err)
+	}
+
+	return application, getDocumentPositions(file, &document), nil
+}
+
+// locateErrors reports each error at the line given by yaml and the column
+// of its node. Syntax errors have no nodes and keep the line only.
+func locateErrors(file string, document *yaml.Node, err error) error {
+	var messages []string
+
+	var typeError *yaml.TypeError
+	if errors.As(err, &typeError) {
+		messages = typeError.Errors
+	} else {
+		messages = []string{err.Error()}
+	}
+
+	located := make([]string, 0, len(messages))
+
+	for _, message := range messages {
+		match := yamlErrorRegexp.FindStringSubmatch(message)
+		if match == nil {
+			located = append(located, fmt.Sprintf("%s: %s", file, message))
+
+			continue
+		}
+
+		position := config.Position{File: file}
+		position.Line, _ = strconv.Atoi(match[1])
+
+		if document != nil {
+			position.Column = getColumn(document, position.Line, match[2])
+		}
+
+		located = append(located, fmt.Sprintf("%s: %s", position, match[2]))
+	}
+
+	return errors.New(strings.Join(located, "\n"))
+}
+
+// getColumn returns the column of the key named by an unknown field error,
+// or of the first node of the line.
+func getColumn(node *yaml.Node, line int, message string) int {
+	var field string
+	if match := fieldRegexp.FindStringSubmatch(message); match != nil {
+		field = match[1]
+	}
+
+	column := 0
+
+	var visit func(node *yaml.Node)
+	visit = func(node *yaml.Node) {
+		if node.Line == line && node.Kind == yaml.ScalarNode && (field == "" || node.Value == field) {
+			if column == 0 || node.Column < column {
+				column = node.Column
+			}
+		}
+
+		for _, child := range node.Content {
+			visit(child)
+		}
+	}
+
+	visit(node)
+
+	return column
+}
+
+func getDocumentPositions(file string, document *yaml.Node) config.Positions {
+	positions := make(config.Positions)
+
+	for _, node := range document.Content {
+		addPositions(positions, file, "", node)
+	}
+
+	return positions
+}
+
+// addPositions records the position of every mapping key and list entry.
+// Entries of lists of mappings are named by their name field when they have
+// one, by their index otherwise.
+func addPositions(positions config.Positions, file string, path string, node *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			keyPath := key.Value
+			if path != "" {
+				keyPath = path + "." + key.Value
+			}
+
+			positions[keyPath] = config.Position{File: file, Line: key.Line, Column: key.Column}
+
+			addPositions(positions, file, keyPath, value)
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			itemPath := fmt.Sprintf("%s[%s]", path, getEntryName(item, i))
+
+			positions[itemPath] = config.Position{File: file, Line: item.Line, Column: item.Column}
+
+			addPositions(positions, file, itemPath, item)
+		}
+	}
+}
+
+func getEntryName(node *yaml.Node, index int) string {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "name" && node.Content[i+1].Kind == yaml.ScalarNode {
+				return node.Content[i+1].Value
+			}
+		}
+	}
+
+	return strconv.Itoa(index)
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"project-helper/internal/config"
+)
+
+// ProjectConfigNames are looked up from the working directory up to the root.
+var ProjectConfigNames = []string{".project-helper.yaml", "project-helper.yaml"}
+
+// findProjectConfig returns the project config closest to dir.
+func findProjectConfig(dir string) (string, bool) {
+	for {
+		for _, name := range ProjectConfigNames {
+			path := filepath.Join(dir, name)
+
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path, true
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+
+		dir = parent
+	}
+}
+
+func isSameFile(first string, second string) bool {
+	if first == "" || second == "" {
+		return false
+	}
+
+	firstInfo, err := os.Stat(first)
+	if err != nil {
+		return false
+	}
+
+	secondInfo, err := os.Stat(second)
+	if err != nil {
+		return false
+	}
+
+	return os.SameFile(firstInfo, secondInfo)
+}
+
+// layerConfig applies a project config over the global one. Entries with the
+// name of a global entry replace it, the others are appended.
+func layerConfig(global *config.Application, project *config.Application) *config.Application {
+	layered := *global
+
+	if project.Name != "" {
+		layered.Name = project.Name
+	}
+
+	if project.Path != "" {
+		layered.Path = project.Path
+	}
+
+	layered.Operations = layerByName(global.Operations, project.Operations, func(operation config.Operation) string {
+		return operation.Name
+	})
+	layered.DynamicFlags = layerByName(global.DynamicFlags, project.DynamicFlags, func(dynamicFlag config.DynamicFlag) string {
+		return dynamicFlag.Name
+	})
+	layered.PredefinedArgs = layerByName(global.PredefinedArgs, project.PredefinedArgs, func(predefinedArg config.PredefinedArg) string {
+		return predefinedArg.Name
+	})
+	layered.Vars = layerByName(global.Vars, project.Vars, func(variable config.Var) string {
+		return variable.Name
+	})
+
+	return &layered
+}
+
+func layerByName[S ~[]E, E any](global S, project S, getName func(E) string) S {
+	layered := make(S, 0, len(global)+len(project))
+	indexes := make(map[string]int, len(global))
+
+	for _, entry := range global {
+		indexes[getName(entry)] = len(layered)
+		layered = append(layered, entry)
+	}
+
+	for _, entry := range project {
+		if index, ok := indexes[getName(entry)]; ok {
+			layered[index] = entry
+
+			continue
+		}
+
+		layered = append(layered, entry)
+	}
+
+	return layered
+}
+
+// resolveProjectPath defaults the path of a project config to its directory
+// and resolves relative paths against it.
+func (s *Service) resolveProjectPath(file string, path string) (string, error) {
+	if path == "" {
+		return filepath.Dir(file), nil
+	}
+
+	return s.resolveConfigPath(file, path)
+}
+
+// resolveConfigPath resolves a relative path against the directory of the
+// config file declaring it, so it doesn't depend on the working directory.
+func (s *Service) resolveConfigPath(file string, path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	path, err := s.pathResolver.ResolvePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	if filepath.IsAbs(path) {
+		return path, nil
+	}
+
+	return filepath.Abs(filepath.Join(filepath.Dir(file), path))
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/config"
+)
+
+func TestFindProjectConfig(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	nested := filepath.Join(root, "service", "cmd")
+	require.NoError(t, os.MkdirAll(nested, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "project-helper.yaml"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "service", ".project-helper.yaml"), nil, 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(nested, ".project-helper.yaml"), 0o700))
+
+	tests := map[string]struct {
+		dir      string
+		expected string
+	}{
+		"in the directory":       {dir: root, expected: filepath.Join(root, "project-helper.yaml")},
+		"closest parent":         {dir: nested, expected: filepath.Join(root, "service", ".project-helper.yaml")},
+		"dot file is preferred":  {dir: filepath.Join(root, "service"), expected: filepath.Join(root, "service", ".project-helper.yaml")},
+		"without project config": {dir: t.TempDir()},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path, ok := findProjectConfig(testCase.dir)
+
+			assert.Equal(t, testCase.expected != "", ok)
+			assert.Equal(t, testCase.expected, path)
+		})
+	}
+}
+
+func TestLayerProjectConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	projectPath := filepath.Join(dir, ".project-helper.yaml")
+
+	err := os.WriteFile(projectPath, []byte(`name: project
+operations:
+  - name: build
+    cmd: make
+  - name: test
+    cmd: go
+vars:
+  - name: image
+    value: project
+`), 0o600)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		service          *Service
+		expectedConfig   *config.Application
+		expectedFiles    []config.File
+		expectedPosition string
+	}{
+		"over global config": {
+			service: &Service{
+				config: &config.Application{
+					Name: "global",
+					Path: "/global",
+					Operations: config.Operations{
+						{Name: "deploy", Cmd: "kubectl"},
+						{Name: "build", Cmd: "docker"},
+					},
+					DynamicFlags: config.DynamicFlags{{Name: "env"}},
+					Vars:         config.Vars{{Name: "image", Value: "global"}, {Name: "tag", Value: "latest"}},
+				},
+				positions: config.Positions{"operations[deploy]": {File: "global.yaml", Line: 2, Column: 5}},
+				files:     []config.File{{Path: "global.yaml", Scope: config.GlobalScope}},
+			},
+			expectedConfig: &config.Application{
+				Name: "project",
+				Path: dir,
+				Operations: config.Operations{
+					{Name: "deploy", Cmd: "kubectl"},
+					{Name: "build", Cmd: "make"},
+					{Name: "test", Cmd: "go"},
+				},
+				DynamicFlags:   config.DynamicFlags{{Name: "env"}},
+				PredefinedArgs: config.PredefinedArgs{},
+				Vars:           config.Vars{{Name: "image", Value: "project"}, {Name: "tag", Value: "latest"}},
+			},
+			expectedFiles: []config.File{
+				{Path: "global.yaml", Scope: config.GlobalScope},
+				{Path: projectPath, Scope: config.ProjectScope},
+			},
+			expectedPosition: "global.yaml:2:5",
+		},
+		"without global config": {
+			service: &Service{},
+			expectedConfig: &config.Application{
+				Name: "project",
+				Path: dir,
+				Operations: config.Operations{
+					{Name: "build", Cmd: "make"},
+					{Name: "test", Cmd: "go"},
+				},
+				Vars: config.Vars{{Name: "image", Value: "project"}},
+			},
+			expectedFiles:    []config.File{{Path: projectPath, Scope: config.ProjectScope}},
+			expectedPosition: projectPath + ":2:1",
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.service.layerProjectConfig(projectPath)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedConfig, testCase.service.GetConfig())
+			assert.Equal(t, testCase.expectedFiles, testCase.service.GetFiles())
+			assert.Equal(t, testCase.expectedPosition, testCase.service.GetPosition("operations[deploy]").String())
+			assert.Equal(t, projectPath+":3:5", testCase.service.GetPosition("operations[build]").String())
+		})
+	}
+}
+
+func TestResolveProjectPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path     string
+		expected string
+	}{
+		"empty path":    {expected: "/repo"},
+		"relative path": {path: "service", expected: "/repo/service"},
+		"absolute path": {path: "/srv/app", expected: "/srv/app"},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &Service{pathResolver: newPathResolver(t)}
+
+			path, err := svc.resolveProjectPath("/repo/.project-helper.yaml", testCase.path)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, path)
+		})
+	}
+}
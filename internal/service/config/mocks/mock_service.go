@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPathResolver is a mock of PathResolver interface.
+type MockPathResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockPathResolverMockRecorder
+}
+
+// MockPathResolverMockRecorder is the mock recorder for MockPathResolver.
+type MockPathResolverMockRecorder struct {
+	mock *MockPathResolver
+}
+
+// NewMockPathResolver creates a new mock instance.
+func NewMockPathResolver(ctrl *gomock.Controller) *MockPathResolver {
+	mock := &MockPathResolver{ctrl: ctrl}
+	mock.recorder = &MockPathResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPathResolver) EXPECT() *MockPathResolverMockRecorder {
+	return m.recorder
+}
+
+// ResolvePath mocks base method.
+func (m *MockPathResolver) ResolvePath(path string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePath", path)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePath indicates an expected call of ResolvePath.
+func (mr *MockPathResolverMockRecorder) ResolvePath(path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePath", reflect.TypeOf((*MockPathResolver)(nil).ResolvePath), path)
+}
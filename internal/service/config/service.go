@@ -1,28 +1,44 @@
 package config
 
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
 import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/adrg/xdg"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
-	"gopkg.in/yaml.v2"
 	"project-helper/internal/config"
-	"project-helper/internal/domain/entity"
 	domainerrors "project-helper/internal/domain/errors"
 )
 
+type (
+	PathResolver interface {
+		ResolvePath(path string) (string, error)
+	}
+)
+
 type Service struct {
+	pathResolver   PathResolver
+	workingDir     string
+	applicationEnv string
+	base           *application
+	applications   []application
+	projectPath    string
+	selected       string
 	config         *config.Application
+	files          []config.File
+	positions      config.Positions
 	predefinedArgs map[string]config.PredefinedArg
 	operationsMap  map[string]config.Operation
 	additionalArgs map[string]string
 }
 
-func NewService() (*Service, error) {
-	svc := &Service{}
+func NewService(pathResolver PathResolver) (*Service, error) {
+	svc := &Service{pathResolver: pathResolver}
 
 	err := initService(svc)
 	if err != nil {
@@ -33,36 +49,104 @@ func NewService() (*Service, error) {
 }
 
 func initService(s *Service) error {
-	configPath, err := s.readConfigPath()
+	var err error
+
+	s.workingDir, err = os.Getwd()
+	if err != nil {
+		return errors.Wrap(err, "failed to get working directory")
+	}
+
+	s.applicationEnv = os.Getenv(ApplicationEnv)
+
+	projectPath, _ := findProjectConfig(s.workingDir)
+
+	configPath, err := s.readConfigPath(projectPath == "")
 	if err != nil {
 		return errors.Wrap(err, "failed to read config path")
 	}
 
-	file, err := os.Open(configPath)
+	if configPath != "" {
+		if err = s.loadApplications(configPath); err != nil {
+			return err
+		}
+	}
+
+	if projectPath != "" && !isSameFile(projectPath, configPath) {
+		s.projectPath = projectPath
+	}
+
+	return nil
+}
+
+func (s *Service) layerProjectConfig(projectPath string) error {
+	project, positions, err := loadConfig(projectPath)
 	if err != nil {
-		return errors.Wrap(err, "failed to open config file")
+		return err
 	}
 
-	s.config = &config.Application{}
+	if len(project.Applications) != 0 {
+		return errors.Errorf("%s: applications are only supported in the global config", positions["applications"])
+	}
 
-	err = yaml.NewDecoder(file).Decode(s.config)
+	project.Path, err = s.resolveProjectPath(projectPath, project.Path)
 	if err != nil {
-		return errors.Wrap(err, "failed to decode config file")
+		return errors.Wrap(err, "failed to resolve project application path")
 	}
 
-	s.predefinedArgs = s.config.GetPredefinedArgs()
-	s.operationsMap = s.config.GetOperationsMap()
-	s.additionalArgs = map[string]string{
-		entity.ApplicationPathTag: s.config.Path,
+	if s.config == nil {
+		s.config, s.positions = project, positions
+	} else {
+		s.config = layerConfig(s.config, project)
+
+		for path, position := range positions {
+			s.positions[path] = position
+		}
 	}
 
+	s.files = append(s.files, config.File{Path: projectPath, Scope: config.ProjectScope})
+
+	log.Debug().Str("config.path", projectPath).Msg("project config file found")
+
 	return nil
 }
 
+func loadConfig(path string) (*config.Application, config.Positions, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open config file")
+	}
+
+	application, positions, err := decodeConfig(path, data)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode config file")
+	}
+
+	return application, positions, nil
+}
+
 func (s *Service) GetConfig() *config.Application {
 	return s.config
 }
 
+// GetPosition locates a config path such as operations[deploy].args in the
+// config files, falling back to the last loaded file.
+func (s *Service) GetPosition(path string) config.Position {
+	if position, ok := s.positions.Get(path); ok {
+		return position
+	}
+
+	if len(s.files) == 0 {
+		return config.Position{}
+	}
+
+	return config.Position{File: s.files[len(s.files)-1].Path}
+}
+
+// GetFiles returns the loaded config files, the global one first.
+func (s *Service) GetFiles() []config.File {
+	return s.files
+}
+
 func (s *Service) GetPredefinedArgs() map[string]config.PredefinedArg {
 	return s.predefinedArgs
 }
@@ -90,14 +174,29 @@ func (s *Service) GetAdditionalArgs() map[string]string {
 	return args
 }
 
-func (s *Service) readConfigPath() (string, error) {
+// readConfigPath returns the global config path. It is optional when a project
+// config is found.
+func (s *Service) readConfigPath(required bool) (string, error) {
 	configPath := os.Getenv("CONFIG_PATH")
 	if configPath == "" {
 		configPath, _ = xdg.SearchConfigFile("project-helper/application.yaml")
 	}
 
 	if configPath == "" {
-		return "", errors.Errorf("config file not found. 'CONFIG_PATH' environment variable and %s", filepath.Join(xdg.ConfigHome, "project-helper/application.yaml"))
+		configPath, _ = xdg.SearchConfigFile("project-helper/applications")
+	}
+
+	if configPath == "" && !required {
+		return "", nil
+	}
+
+	if configPath == "" {
+		return "", errors.Errorf(
+			"config file not found. 'CONFIG_PATH' environment variable, %s, %s and %s in the working directory or its parents",
+			filepath.Join(xdg.ConfigHome, "project-helper/application.yaml"),
+			filepath.Join(xdg.ConfigHome, "project-helper/applications"),
+			strings.Join(ProjectConfigNames, ", "),
+		)
 	}
 
 	if _, err := os.Stat(configPath); err != nil {
@@ -6,16 +6,18 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
 	"project-helper/internal/config"
 	domainerrors "project-helper/internal/domain/errors"
+	"project-helper/internal/service/config/mocks"
 )
 
 func TestNewService(t *testing.T) {
-	t.Parallel()
-
 	applicationConfig := createApplication()
 
 	tests := map[string]struct {
@@ -33,7 +35,7 @@ func TestNewService(t *testing.T) {
 
 				require.NoError(t, err)
 
-				_ = os.Setenv("CONFIG_PATH", create.Name())
+				t.Setenv("CONFIG_PATH", create.Name())
 			},
 			output: &Service{
 				config: &applicationConfig,
@@ -83,13 +85,63 @@ func TestNewService(t *testing.T) {
 				additionalArgs: map[string]string{"application-path": applicationConfig.Path},
 			},
 		},
+		"with map values": {
+			preconditions: func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "config.yaml")
+
+				err := os.WriteFile(path, []byte("name: test\npredefinedArgs:\n  - name: env\n    type: map\n    args:\n      - name: dev\n        values:\n          PORT: 8080\n          HOST: localhost\n"), 0o600)
+				require.NoError(t, err)
+
+				t.Setenv("CONFIG_PATH", path)
+			},
+			output: &Service{
+				config: &config.Application{
+					Name: "test",
+					PredefinedArgs: config.PredefinedArgs{{
+						Name: "env", Type: "map", Args: config.Args{{Name: "dev", Values: []string{"PORT=8080", "HOST=localhost"}}},
+					}},
+				},
+				predefinedArgs: map[string]config.PredefinedArg{"env": {
+					Name: "env", Type: "map", Args: config.Args{{Name: "dev", Values: []string{"PORT=8080", "HOST=localhost"}}},
+				}},
+				additionalArgs: map[string]string{"application-path": ""},
+			},
+		},
+		"with unknown fields": {
+			preconditions: func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "config.yaml")
+
+				err := os.WriteFile(path, []byte("name: test\noperations:\n  - name: build\n    runbefore: []\n    shortname: b\n"), 0o600)
+				require.NoError(t, err)
+
+				t.Setenv("CONFIG_PATH", path)
+			},
+			expectedError: errors.New("config.yaml:4:5: field runbefore not found in type config.Operation"),
+		},
+		"with invalid yaml": {
+			preconditions: func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "config.yaml")
+
+				err := os.WriteFile(path, []byte("name: test\noperations:\n  - name: build\n   cmd: [\n"), 0o600)
+				require.NoError(t, err)
+
+				t.Setenv("CONFIG_PATH", path)
+			},
+			expectedError: errors.New("config.yaml:2: did not find expected '-' indicator"),
+		},
 	}
 
 	for name, testCase := range tests {
 		t.Run(name, func(t *testing.T) {
+			t.Setenv(ApplicationEnv, "")
+			chdir(t, t.TempDir())
+
 			testCase.preconditions(t)
 
-			svc, err := NewService()
+			svc, err := NewService(newPathResolver(t))
+			if err == nil {
+				err = svc.SelectApplication("")
+			}
 
 			if testCase.expectedError != nil {
 				require.Error(t, err)
@@ -105,6 +157,55 @@ func TestNewService(t *testing.T) {
 	}
 }
 
+func TestGetPosition(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`name: test
+operations:
+  - name: deploy
+    args: [ "${{env}}" ]
+    runBefore:
+      - name: build
+dynamicFlags:
+  - name: env
+predefinedArgs:
+  - name: t1
+    type: bogus
+vars:
+  - name: image
+    value: ${{var.tag}}
+`)
+
+	var document yamlv3.Node
+	require.NoError(t, yamlv3.Unmarshal(data, &document))
+
+	svc := &Service{
+		files:     []config.File{{Path: "config.yaml", Scope: config.GlobalScope}},
+		positions: getDocumentPositions("config.yaml", &document),
+	}
+
+	tests := map[string]struct {
+		path     string
+		expected string
+	}{
+		"list entry":                 {path: "dynamicFlags[env]", expected: "config.yaml:8:5"},
+		"field of list entry":        {path: "operations[deploy].args", expected: "config.yaml:4:5"},
+		"nested list entry":          {path: "operations[deploy].runBefore[build]", expected: "config.yaml:6:9"},
+		"unknown path of known list": {path: "operations[deploy].vars[image]", expected: "config.yaml:3:5"},
+		"list item":                  {path: "operations[deploy].args[0]", expected: "config.yaml:4:13"},
+		"field of predefined arg":    {path: "predefinedArgs[t1].type", expected: "config.yaml:11:5"},
+		"field of var":               {path: "vars[image].value", expected: "config.yaml:14:5"},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, svc.GetPosition(testCase.path).String())
+		})
+	}
+}
+
 func TestGetOperation(t *testing.T) {
 	t.Parallel()
 
@@ -207,3 +308,27 @@ func createApplication() config.Application {
 		},
 	}
 }
+
+// chdir changes the working directory for the test, which must not run in
+// parallel.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	previous, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+
+	t.Cleanup(func() {
+		require.NoError(t, os.Chdir(previous))
+	})
+}
+
+// newPathResolver returns a resolver keeping config paths as they are.
+func newPathResolver(t *testing.T) *mocks.MockPathResolver {
+	pathResolver := mocks.NewMockPathResolver(gomock.NewController(t))
+	pathResolver.EXPECT().ResolvePath(gomock.Any()).DoAndReturn(func(path string) (string, error) {
+		return path, nil
+	}).AnyTimes()
+
+	return pathResolver
+}
@@ -51,3 +51,55 @@ func (mr *MockConfigServiceMockRecorder) GetConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockConfigService)(nil).GetConfig))
 }
+
+// MockStateService is a mock of StateService interface.
+type MockStateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateServiceMockRecorder
+}
+
+// MockStateServiceMockRecorder is the mock recorder for MockStateService.
+type MockStateServiceMockRecorder struct {
+	mock *MockStateService
+}
+
+// NewMockStateService creates a new mock instance.
+func NewMockStateService(ctrl *gomock.Controller) *MockStateService {
+	mock := &MockStateService{ctrl: ctrl}
+	mock.recorder = &MockStateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateService) EXPECT() *MockStateServiceMockRecorder {
+	return m.recorder
+}
+
+// GetStickyFlags mocks base method.
+func (m *MockStateService) GetStickyFlags() (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStickyFlags")
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStickyFlags indicates an expected call of GetStickyFlags.
+func (mr *MockStateServiceMockRecorder) GetStickyFlags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStickyFlags", reflect.TypeOf((*MockStateService)(nil).GetStickyFlags))
+}
+
+// SaveStickyFlags mocks base method.
+func (m *MockStateService) SaveStickyFlags(stickyFlags map[string][]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStickyFlags", stickyFlags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStickyFlags indicates an expected call of SaveStickyFlags.
+func (mr *MockStateServiceMockRecorder) SaveStickyFlags(stickyFlags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStickyFlags", reflect.TypeOf((*MockStateService)(nil).SaveStickyFlags), stickyFlags)
+}
@@ -4,6 +4,7 @@ package parser
 
 import (
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
@@ -11,55 +12,108 @@ import (
 	"project-helper/internal/domain/entity"
 )
 
-type ConfigService interface {
-	GetConfig() *config.Application
-}
+type (
+	ConfigService interface {
+		GetConfig() *config.Application
+	}
+	StateService interface {
+		GetStickyFlags() (map[string][]string, error)
+		SaveStickyFlags(stickyFlags map[string][]string) error
+	}
+)
+
+var flagSet = newFlagSet()
 
-var flagSet = func() *pflag.FlagSet {
+func newFlagSet() *pflag.FlagSet {
 	flags := pflag.NewFlagSet("run", pflag.ContinueOnError)
 
 	flags.ParseErrorsWhitelist.UnknownFlags = true
 
 	return flags
-}()
+}
 
 type Service struct {
 	configService ConfigService
+	stateService  StateService
+	// stickyFlags holds the sticky values ParseFlags read, SaveStickyFlags stores them
+	stickyFlags map[string][]string
 }
 
-func NewService(configService ConfigService) *Service {
+func NewService(configService ConfigService, stateService StateService) *Service {
 	return &Service{
 		configService: configService,
+		stateService:  stateService,
 	}
 }
 
 func (s *Service) ParseFlags() (*entity.Flags, error) {
-	flags, err := s.parseFlags()
+	flags, err := s.parseFlags(flagSet, os.Args[1:], true)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse flags")
 	}
 
-	err = flags.Validate()
+	return flags, nil
+}
+
+// SaveStickyFlags stores the sticky flags of the last ParseFlags call. It is
+// called once the operations resolved, so a rejected run keeps the old values.
+func (s *Service) SaveStickyFlags() error {
+	if s.stickyFlags == nil {
+		return nil
+	}
+
+	if err := s.stateService.SaveStickyFlags(s.stickyFlags); err != nil {
+		return errors.Wrap(err, "failed to save sticky flags")
+	}
+
+	return nil
+}
+
+// ParseDynamicFlags parses the given args without requiring an operation and
+// without storing sticky flags, for commands that only need flag values.
+func (s *Service) ParseDynamicFlags(args []string) (*entity.Flags, error) {
+	flags, err := s.parseFlags(newFlagSet(), args, false)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to validate flags")
+		return nil, errors.Wrap(err, "failed to parse flags")
 	}
 
 	return flags, nil
 }
 
-func (s *Service) parseFlags() (*entity.Flags, error) {
+func (s *Service) parseFlags(flagSet *pflag.FlagSet, args []string, saveSticky bool) (*entity.Flags, error) {
 	flags := entity.NewFlags()
 
-	flagSet.StringVarP(&flags.Operation, "operation", "o", "", "Operation to run")
+	flagSet.StringSliceVarP(&flags.Operations, entity.OperationFlag, entity.OperationShortFlag, []string{}, "Operations to run")
+	flagSet.BoolVar(&flags.Parallel, entity.ParallelFlag, false, "Run operations in parallel")
+	flagSet.BoolVar(&flags.NoInput, entity.NoInputFlag, false, "Disable interactive prompts for missing flags")
+	flagSet.BoolVar(&flags.KeepTmp, entity.KeepTmpFlag, false, "Keep temporary directories created by tmpdir tags")
+	flagSet.StringVar(&flags.Application, entity.ApplicationFlag, "", "Application of the config to use")
 
 	applicationConfig := s.configService.GetConfig()
 
+	// pflag panics on a flag defined twice
+	if err := applicationConfig.ValidateFlagNames(); err != nil {
+		return nil, errors.Wrap(err, "dynamic flags can't be defined")
+	}
+
+	stickyFlags, err := s.getStickyFlags(applicationConfig.DynamicFlags)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sticky flags")
+	}
+
 	for _, dynamicFlag := range applicationConfig.DynamicFlags {
+		stickyValue, hasStickyValue := stickyFlags[dynamicFlag.Name]
+
 		switch dynamicFlag.Type {
 		case entity.String:
 			var value string
 
-			flagSet.StringVarP(&value, dynamicFlag.Name, dynamicFlag.ShortName, dynamicFlag.Default, dynamicFlag.Description)
+			defaultValue := dynamicFlag.Default
+			if hasStickyValue && len(stickyValue) != 0 {
+				defaultValue = stickyValue[0]
+			}
+
+			flagSet.StringVarP(&value, dynamicFlag.Name, dynamicFlag.ShortName, defaultValue, dynamicFlag.Description)
 
 			flags.DynamicFlags[dynamicFlag.Name] = &entity.DynamicFlagValue{
 				Value: &value,
@@ -69,12 +123,34 @@ func (s *Service) parseFlags() (*entity.Flags, error) {
 		case entity.Array:
 			var value []string
 
-			flagSet.StringSliceVarP(&value, dynamicFlag.Name, dynamicFlag.ShortName, []string{}, dynamicFlag.Description)
+			defaultValue := []string{}
+			if hasStickyValue {
+				defaultValue = stickyValue
+			}
+
+			flagSet.StringSliceVarP(&value, dynamicFlag.Name, dynamicFlag.ShortName, defaultValue, dynamicFlag.Description)
 
 			flags.DynamicFlags[dynamicFlag.Name] = &entity.DynamicFlagValue{
-				Value: &value,
-				Name:  dynamicFlag.Name,
-				Type:  dynamicFlag.Type,
+				Value:     &value,
+				Name:      dynamicFlag.Name,
+				Type:      dynamicFlag.Type,
+				Separator: dynamicFlag.Separator,
+			}
+		case entity.Map:
+			var value map[string]string
+
+			defaultValue := map[string]string{}
+			if hasStickyValue {
+				defaultValue = toMap(stickyValue)
+			}
+
+			flagSet.StringToStringVarP(&value, dynamicFlag.Name, dynamicFlag.ShortName, defaultValue, dynamicFlag.Description)
+
+			flags.DynamicFlags[dynamicFlag.Name] = &entity.DynamicFlagValue{
+				Value:     &value,
+				Name:      dynamicFlag.Name,
+				Type:      dynamicFlag.Type,
+				Separator: dynamicFlag.Separator,
 			}
 		default:
 			return nil, errors.Errorf("unknown flag type %s", dynamicFlag.Type)
@@ -82,11 +158,120 @@ func (s *Service) parseFlags() (*entity.Flags, error) {
 
 	}
 
-	err := flagSet.Parse(os.Args[1:])
+	err = flagSet.Parse(args)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse flags")
 	}
 
+	positionalArgs := flagSet.Args()
+	if argsLenAtDash := flagSet.ArgsLenAtDash(); argsLenAtDash != -1 {
+		flags.PassThroughArgs = positionalArgs[argsLenAtDash:]
+		positionalArgs = positionalArgs[:argsLenAtDash]
+	}
+
+	flags.Operations = append(flags.Operations, positionalArgs...)
+
+	if !saveSticky {
+		return flags, nil
+	}
+
+	if err = flags.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate flags")
+	}
+
+	s.stickyFlags = getChangedStickyFlags(flagSet, applicationConfig.DynamicFlags, stickyFlags, flags)
+
 	return flags, nil
 }
+
+// ExtractApplicationFlag returns the --app value and the args without it. The
+// application is selected before commands and dynamic flags are known.
+func ExtractApplicationFlag(args []string) (string, []string) {
+	var (
+		application string
+		rest        = make([]string, 0, len(args))
+	)
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		switch {
+		case arg == "--":
+			return application, append(rest, args[i:]...)
+		case arg == "--"+entity.ApplicationFlag && i+1 < len(args):
+			application = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--"+entity.ApplicationFlag+"="):
+			application = strings.TrimPrefix(arg, "--"+entity.ApplicationFlag+"=")
+		default:
+			rest = append(rest, arg)
+		}
+	}
+
+	return application, rest
+}
+
+func (s *Service) getStickyFlags(dynamicFlags config.DynamicFlags) (map[string][]string, error) {
+	if !hasStickyFlags(dynamicFlags) {
+		return make(map[string][]string), nil
+	}
+
+	return s.stateService.GetStickyFlags()
+}
+
+// getChangedStickyFlags returns the sticky flags with the values given on the
+// command line, or nil when none was given.
+func getChangedStickyFlags(
+	flagSet *pflag.FlagSet,
+	dynamicFlags config.DynamicFlags,
+	stickyFlags map[string][]string,
+	flags *entity.Flags,
+) map[string][]string {
+	changed := false
+
+	for _, dynamicFlag := range dynamicFlags {
+		if !dynamicFlag.Sticky || !flagSet.Changed(dynamicFlag.Name) {
+			continue
+		}
+
+		switch value := flags.DynamicFlags[dynamicFlag.Name].Value.(type) {
+		case *string:
+			stickyFlags[dynamicFlag.Name] = []string{*value}
+		case *[]string:
+			stickyFlags[dynamicFlag.Name] = *value
+		case *map[string]string:
+			stickyFlags[dynamicFlag.Name] = entity.GetMapPairs(*value)
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return stickyFlags
+}
+
+func hasStickyFlags(dynamicFlags config.DynamicFlags) bool {
+	for _, dynamicFlag := range dynamicFlags {
+		if dynamicFlag.Sticky {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toMap(pairs []string) map[string]string {
+	value := make(map[string]string, len(pairs))
+
+	for _, pair := range pairs {
+		if key, keyValue, ok := strings.Cut(pair, "="); ok {
+			value[key] = keyValue
+		}
+	}
+
+	return value
+}
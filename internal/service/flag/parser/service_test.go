@@ -34,7 +34,7 @@ func TestParseFlags(t *testing.T) {
 			},
 			args: []string{"--operation=test", "--flag=value"},
 			expectedFlags: &entity.Flags{
-				Operation: "test",
+				Operations: []string{"test"},
 				DynamicFlags: map[string]*entity.DynamicFlagValue{
 					"flag": {Name: "flag", Type: entity.String, Value: utils.MakePointer("value")},
 				},
@@ -50,12 +50,153 @@ func TestParseFlags(t *testing.T) {
 			},
 			args: []string{"--operation=test", "--flag=value1,value2"},
 			expectedFlags: &entity.Flags{
-				Operation: "test",
+				Operations: []string{"test"},
 				DynamicFlags: map[string]*entity.DynamicFlagValue{
 					"flag": {Name: "flag", Type: entity.Array, Value: &[]string{"value1", "value2"}},
 				},
 			},
 		},
+		"with multiple operations": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{})
+			},
+			args: []string{"build", "--parallel", "test", "-o", "lint,vet", "--", "-v"},
+			expectedFlags: &entity.Flags{
+				Operations:      []string{"lint", "vet", "build", "test"},
+				Parallel:        true,
+				PassThroughArgs: []string{"-v"},
+				DynamicFlags:    map[string]*entity.DynamicFlagValue{},
+			},
+		},
+		"with pass through args": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "flag", Type: entity.String},
+					},
+				})
+			},
+			args: []string{"--operation=test", "--flag=value", "--", "-run", "TestFoo", "--flag=other"},
+			expectedFlags: &entity.Flags{
+				Operations:      []string{"test"},
+				PassThroughArgs: []string{"-run", "TestFoo", "--flag=other"},
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"flag": {Name: "flag", Type: entity.String, Value: utils.MakePointer("value")},
+				},
+			},
+		},
+		"valid map flags": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "label", Type: entity.Map, Separator: " "},
+					},
+				})
+			},
+			args: []string{"--operation=test", "--label", "team=core", "--label", "tier=2"},
+			expectedFlags: &entity.Flags{
+				Operations: []string{"test"},
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"label": {Name: "label", Type: entity.Map, Value: &map[string]string{"team": "core", "tier": "2"}, Separator: " "},
+				},
+			},
+		},
+		"sticky map flag": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "label", Type: entity.Map, Sticky: true},
+					},
+				})
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{
+					"label": {"team=core"},
+				}, nil)
+				t.stateService.EXPECT().SaveStickyFlags(map[string][]string{
+					"label": {"team=platform", "tier=1"},
+				}).Return(nil)
+			},
+			args: []string{"--operation=test", "--label=team=platform,tier=1"},
+			expectedFlags: &entity.Flags{
+				Operations: []string{"test"},
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"label": {Name: "label", Type: entity.Map, Value: &map[string]string{"team": "platform", "tier": "1"}},
+				},
+			},
+		},
+		"sticky flag default from state": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "env", Type: entity.String, Default: "dev", Sticky: true},
+						{Name: "services", Type: entity.Array, Sticky: true},
+					},
+				})
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{
+					"env":      {"prod"},
+					"services": {"api", "web"},
+				}, nil)
+			},
+			args: []string{"--operation=test"},
+			expectedFlags: &entity.Flags{
+				Operations: []string{"test"},
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env":      {Name: "env", Type: entity.String, Value: utils.MakePointer("prod")},
+					"services": {Name: "services", Type: entity.Array, Value: &[]string{"api", "web"}},
+				},
+			},
+		},
+		"sticky flag provided is saved": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "env", Type: entity.String, Sticky: true},
+						{Name: "services", Type: entity.Array, Sticky: true},
+						{Name: "flag", Type: entity.String},
+					},
+				})
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{
+					"env": {"prod"},
+				}, nil)
+				t.stateService.EXPECT().SaveStickyFlags(map[string][]string{
+					"env":      {"prod"},
+					"services": {"api"},
+				}).Return(nil)
+			},
+			args: []string{"--operation=test", "--services=api", "--flag=value"},
+			expectedFlags: &entity.Flags{
+				Operations: []string{"test"},
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env":      {Name: "env", Type: entity.String, Value: utils.MakePointer("prod")},
+					"services": {Name: "services", Type: entity.Array, Value: &[]string{"api"}},
+					"flag":     {Name: "flag", Type: entity.String, Value: utils.MakePointer("value")},
+				},
+			},
+		},
+		"with error on get sticky flags": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "env", Type: entity.String, Sticky: true},
+					},
+				})
+				t.stateService.EXPECT().GetStickyFlags().Return(nil, assert.AnError)
+			},
+			args:          []string{"--operation=test"},
+			expectedError: errors.New("failed to get sticky flags: assert.AnError general error for testing"),
+		},
+		"with error on save sticky flags": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "env", Type: entity.String, Sticky: true},
+					},
+				})
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{}, nil)
+				t.stateService.EXPECT().SaveStickyFlags(gomock.Any()).Return(assert.AnError)
+			},
+			args:          []string{"--operation=test", "--env=prod"},
+			expectedError: errors.New("failed to save sticky flags: assert.AnError general error for testing"),
+		},
 		"with missing operation": {
 			precondition: func(t *testController) {
 				t.configService.EXPECT().GetConfig().Return(&config.Application{
@@ -63,13 +204,79 @@ func TestParseFlags(t *testing.T) {
 				})
 			},
 			expectedFlags: &entity.Flags{
-				Operation: "test",
+				Operations: []string{"test"},
 				DynamicFlags: map[string]*entity.DynamicFlagValue{
 					"flag": {Name: "flag", Type: entity.String, Value: utils.MakePointer("value")},
 				},
 			},
 			expectedError: errors.New("failed to validate flags: operation not provided"),
 		},
+		"reserved flag operation": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{{Name: "operation", Type: entity.String}},
+				})
+			},
+			args:          []string{"--operation=test"},
+			expectedError: errors.New("dynamic flags can't be defined: dynamicFlags[operation]: name --operation is reserved"),
+		},
+		"reserved flag parallel": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{{Name: "parallel", Type: entity.String}},
+				})
+			},
+			args:          []string{"--operation=test"},
+			expectedError: errors.New("dynamic flags can't be defined: dynamicFlags[parallel]: name --parallel is reserved"),
+		},
+		"reserved flag no-input": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{{Name: "no-input", Type: entity.String}},
+				})
+			},
+			args:          []string{"--operation=test"},
+			expectedError: errors.New("dynamic flags can't be defined: dynamicFlags[no-input]: name --no-input is reserved"),
+		},
+		"reserved flag keep-tmp": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{{Name: "keep-tmp", Type: entity.String}},
+				})
+			},
+			args:          []string{"--operation=test"},
+			expectedError: errors.New("dynamic flags can't be defined: dynamicFlags[keep-tmp]: name --keep-tmp is reserved"),
+		},
+		"reserved flag app": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{{Name: "app", Type: entity.String}},
+				})
+			},
+			args:          []string{"--operation=test"},
+			expectedError: errors.New("dynamic flags can't be defined: dynamicFlags[app]: name --app is reserved"),
+		},
+		"reserved short flag": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{{Name: "output", ShortName: "o", Type: entity.String}},
+				})
+			},
+			args:          []string{"--operation=test"},
+			expectedError: errors.New("dynamicFlags[output]: shortName -o is reserved for --operation"),
+		},
+		"with missing operation and sticky flag provided": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "env", Type: entity.String, Sticky: true},
+					},
+				})
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{}, nil)
+			},
+			args:          []string{"--env=prod"},
+			expectedError: errors.New("failed to validate flags: operation not provided"),
+		},
 		"unknown flag type": {
 			args: []string{"--operation=test"},
 			precondition: func(t *testController) {
@@ -104,6 +311,66 @@ func TestParseFlags(t *testing.T) {
 			service := controller.Build()
 
 			flags, err := service.ParseFlags()
+			if err == nil {
+				err = service.SaveStickyFlags()
+			}
+
+			if testCase.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expectedFlags, flags)
+			}
+		})
+	}
+}
+
+func TestParseDynamicFlags(t *testing.T) {
+	tests := map[string]struct {
+		precondition  func(*testController)
+		args          []string
+		expectedFlags *entity.Flags
+		expectedError error
+	}{
+		"without operation and without saving sticky flags": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "env", Type: entity.String, Sticky: true},
+					},
+				})
+				t.stateService.EXPECT().GetStickyFlags().Return(map[string][]string{}, nil)
+			},
+			args: []string{"--env=prod"},
+			expectedFlags: &entity.Flags{
+				Operations: []string{},
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env": {Name: "env", Type: entity.String, Value: utils.MakePointer("prod")},
+				},
+			},
+		},
+		"unknown flag type": {
+			precondition: func(t *testController) {
+				t.configService.EXPECT().GetConfig().Return(&config.Application{
+					DynamicFlags: []config.DynamicFlag{
+						{Name: "flag", Type: "unknown"},
+					},
+				})
+			},
+			expectedError: errors.New("unknown flag type unknown"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.precondition != nil {
+				testCase.precondition(controller)
+			}
+
+			flags, err := controller.Build().ParseDynamicFlags(testCase.args)
 
 			if testCase.expectedError != nil {
 				require.Error(t, err)
@@ -116,16 +383,58 @@ func TestParseFlags(t *testing.T) {
 	}
 }
 
+func TestExtractApplicationFlag(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		args                []string
+		expectedApplication string
+		expectedArgs        []string
+	}{
+		"without flag": {
+			args:         []string{"-o", "build"},
+			expectedArgs: []string{"-o", "build"},
+		},
+		"separate value": {
+			args:                []string{"--app", "api", "config", "where"},
+			expectedApplication: "api",
+			expectedArgs:        []string{"config", "where"},
+		},
+		"inline value": {
+			args:                []string{"build", "--app=web", "--env", "dev"},
+			expectedApplication: "web",
+			expectedArgs:        []string{"build", "--env", "dev"},
+		},
+		"after pass-through separator": {
+			args:         []string{"build", "--", "--app", "api"},
+			expectedArgs: []string{"build", "--", "--app", "api"},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			application, args := ExtractApplicationFlag(testCase.args)
+
+			assert.Equal(t, testCase.expectedApplication, application)
+			assert.Equal(t, testCase.expectedArgs, args)
+		})
+	}
+}
+
 type testController struct {
 	configService *mocks.MockConfigService
+	stateService  *mocks.MockStateService
 }
 
 func newTestController(ctrl *gomock.Controller) *testController {
 	return &testController{
 		configService: mocks.NewMockConfigService(ctrl),
+		stateService:  mocks.NewMockStateService(ctrl),
 	}
 }
 
 func (t *testController) Build() *Service {
-	return NewService(t.configService)
+	return NewService(t.configService, t.stateService)
 }
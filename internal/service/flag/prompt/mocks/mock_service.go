@@ -0,0 +1,213 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	config "project-helper/internal/config"
+	entity "project-helper/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigService is a mock of ConfigService interface.
+type MockConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigServiceMockRecorder
+}
+
+// MockConfigServiceMockRecorder is the mock recorder for MockConfigService.
+type MockConfigServiceMockRecorder struct {
+	mock *MockConfigService
+}
+
+// NewMockConfigService creates a new mock instance.
+func NewMockConfigService(ctrl *gomock.Controller) *MockConfigService {
+	mock := &MockConfigService{ctrl: ctrl}
+	mock.recorder = &MockConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigService) EXPECT() *MockConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// GetConfig mocks base method.
+func (m *MockConfigService) GetConfig() *config.Application {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*config.Application)
+	return ret0
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockConfigServiceMockRecorder) GetConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockConfigService)(nil).GetConfig))
+}
+
+// MockSourceService is a mock of SourceService interface.
+type MockSourceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceServiceMockRecorder
+}
+
+// MockSourceServiceMockRecorder is the mock recorder for MockSourceService.
+type MockSourceServiceMockRecorder struct {
+	mock *MockSourceService
+}
+
+// NewMockSourceService creates a new mock instance.
+func NewMockSourceService(ctrl *gomock.Controller) *MockSourceService {
+	mock := &MockSourceService{ctrl: ctrl}
+	mock.recorder = &MockSourceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceService) EXPECT() *MockSourceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPredefinedArg mocks base method.
+func (m *MockSourceService) GetPredefinedArg(name string) (config.PredefinedArg, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPredefinedArg", name)
+	ret0, _ := ret[0].(config.PredefinedArg)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPredefinedArg indicates an expected call of GetPredefinedArg.
+func (mr *MockSourceServiceMockRecorder) GetPredefinedArg(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPredefinedArg", reflect.TypeOf((*MockSourceService)(nil).GetPredefinedArg), name)
+}
+
+// MockReferenceService is a mock of ReferenceService interface.
+type MockReferenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferenceServiceMockRecorder
+}
+
+// MockReferenceServiceMockRecorder is the mock recorder for MockReferenceService.
+type MockReferenceServiceMockRecorder struct {
+	mock *MockReferenceService
+}
+
+// NewMockReferenceService creates a new mock instance.
+func NewMockReferenceService(ctrl *gomock.Controller) *MockReferenceService {
+	mock := &MockReferenceService{ctrl: ctrl}
+	mock.recorder = &MockReferenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferenceService) EXPECT() *MockReferenceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOperationTags mocks base method.
+func (m *MockReferenceService) GetOperationTags(application *config.Application, operation config.Operation) ([]*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationTags", application, operation)
+	ret0, _ := ret[0].([]*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationTags indicates an expected call of GetOperationTags.
+func (mr *MockReferenceServiceMockRecorder) GetOperationTags(application, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationTags", reflect.TypeOf((*MockReferenceService)(nil).GetOperationTags), application, operation)
+}
+
+// MockTerminalService is a mock of TerminalService interface.
+type MockTerminalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTerminalServiceMockRecorder
+}
+
+// MockTerminalServiceMockRecorder is the mock recorder for MockTerminalService.
+type MockTerminalServiceMockRecorder struct {
+	mock *MockTerminalService
+}
+
+// NewMockTerminalService creates a new mock instance.
+func NewMockTerminalService(ctrl *gomock.Controller) *MockTerminalService {
+	mock := &MockTerminalService{ctrl: ctrl}
+	mock.recorder = &MockTerminalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTerminalService) EXPECT() *MockTerminalServiceMockRecorder {
+	return m.recorder
+}
+
+// Input mocks base method.
+func (m *MockTerminalService) Input(message string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Input", message)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Input indicates an expected call of Input.
+func (mr *MockTerminalServiceMockRecorder) Input(message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Input", reflect.TypeOf((*MockTerminalService)(nil).Input), message)
+}
+
+// IsInteractive mocks base method.
+func (m *MockTerminalService) IsInteractive() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInteractive")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsInteractive indicates an expected call of IsInteractive.
+func (mr *MockTerminalServiceMockRecorder) IsInteractive() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInteractive", reflect.TypeOf((*MockTerminalService)(nil).IsInteractive))
+}
+
+// MultiSelect mocks base method.
+func (m *MockTerminalService) MultiSelect(message string, options []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiSelect", message, options)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiSelect indicates an expected call of MultiSelect.
+func (mr *MockTerminalServiceMockRecorder) MultiSelect(message, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSelect", reflect.TypeOf((*MockTerminalService)(nil).MultiSelect), message, options)
+}
+
+// Select mocks base method.
+func (m *MockTerminalService) Select(message string, options []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", message, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockTerminalServiceMockRecorder) Select(message, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockTerminalService)(nil).Select), message, options)
+}
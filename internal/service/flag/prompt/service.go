@@ -0,0 +1,276 @@
+package prompt
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+	domainerrors "project-helper/internal/domain/errors"
+)
+
+type (
+	ConfigService interface {
+		GetConfig() *config.Application
+	}
+	SourceService interface {
+		GetPredefinedArg(name string) (config.PredefinedArg, bool, error)
+	}
+	ReferenceService interface {
+		GetOperationTags(application *config.Application, operation config.Operation) ([]*entity.TagExpression, error)
+	}
+	TerminalService interface {
+		IsInteractive() bool
+		Input(message string) (string, error)
+		Select(message string, options []string) (string, error)
+		MultiSelect(message string, options []string) ([]string, error)
+	}
+)
+
+type Service struct {
+	configService    ConfigService
+	sourceService    SourceService
+	referenceService ReferenceService
+	terminalService  TerminalService
+}
+
+func NewService(
+	configService ConfigService,
+	sourceService SourceService,
+	referenceService ReferenceService,
+	terminalService TerminalService,
+) *Service {
+	return &Service{
+		configService:    configService,
+		sourceService:    sourceService,
+		referenceService: referenceService,
+		terminalService:  terminalService,
+	}
+}
+
+func (s *Service) PromptMissingFlags(flags *entity.Flags, operation config.Operation) error {
+	if flags == nil {
+		return domainerrors.ErrorNilInput
+	}
+
+	if !s.terminalService.IsInteractive() {
+		return nil
+	}
+
+	application := s.configService.GetConfig()
+
+	dynamicFlags := make(map[string]config.DynamicFlag)
+	for _, dynamicFlag := range application.DynamicFlags {
+		dynamicFlags[dynamicFlag.Name] = dynamicFlag
+	}
+
+	return s.promptOperationFlags(flags, application, operation, dynamicFlags)
+}
+
+func (s *Service) promptOperationFlags(
+	flags *entity.Flags,
+	application *config.Application,
+	operation config.Operation,
+	dynamicFlags map[string]config.DynamicFlag,
+) error {
+	for _, beforeOperation := range operation.RunBefore {
+		if err := s.promptOperationFlags(flags, application, beforeOperation, dynamicFlags); err != nil {
+			return errors.Wrapf(err, "failed to prompt flags for before operation %s", beforeOperation.Name)
+		}
+	}
+
+	requiredFlags, err := s.getRequiredFlags(application, operation)
+	if err != nil {
+		return errors.Wrap(err, "failed to get required flags")
+	}
+
+	for _, name := range requiredFlags {
+		name = strings.TrimPrefix(name, entity.FlagTagNamespace+".")
+
+		dynamicFlag, ok := dynamicFlags[name]
+		if !ok {
+			if flagName, _, found := strings.Cut(name, "."); found {
+				name = flagName
+				dynamicFlag, ok = dynamicFlags[name]
+				ok = ok && dynamicFlag.Type == entity.Map
+			}
+		}
+
+		if !ok || isPredefinedFlag(operation, name) || !entity.IsEmpty(flags.DynamicFlags[name]) {
+			continue
+		}
+
+		value, err := s.promptFlag(dynamicFlag, s.getChoices(operation, name))
+		if err != nil {
+			return errors.Wrapf(err, "failed to prompt flag %s", name)
+		}
+
+		flags.DynamicFlags[name] = value
+	}
+
+	return nil
+}
+
+// getRequiredFlags returns the flags of the predefinedArgsTag bindings and the
+// tags without a default the operation reaches through its args, execution
+// path, vars and predefinedArgs values.
+func (s *Service) getRequiredFlags(application *config.Application, operation config.Operation) ([]string, error) {
+	var requiredFlags []string
+
+	for _, predefinedArgsTag := range operation.GetPredefinedArgsTags() {
+		requiredFlags = append(requiredFlags, predefinedArgsTag.GetFlagNames()...)
+	}
+
+	tags, err := s.referenceService.GetOperationTags(application, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tagExpression := range tags {
+		if _, ok := tagExpression.Filters.GetDefault(); ok {
+			continue
+		}
+
+		requiredFlags = append(requiredFlags, tagExpression.Name)
+	}
+
+	return requiredFlags, nil
+}
+
+func (s *Service) getChoices(operation config.Operation, name string) []string {
+	tableName, position, components := name, 0, 1
+
+	for _, predefinedArgsTag := range operation.GetPredefinedArgsTags() {
+		flagNames := predefinedArgsTag.GetFlagNames()
+		if index := slices.Index(flagNames, name); index != -1 {
+			tableName, position, components = predefinedArgsTag.Value, index, len(flagNames)
+
+			break
+		}
+	}
+
+	predefinedArg, ok, err := s.sourceService.GetPredefinedArg(tableName)
+	if err != nil {
+		log.Debug().Err(err).Str("flag", name).Msg("Failed to get choices")
+
+		return nil
+	}
+
+	if !ok {
+		return nil
+	}
+
+	var choices []string
+	for _, arg := range predefinedArg.Args {
+		if isPattern(arg.Name) {
+			continue
+		}
+
+		keys := strings.Split(arg.Name, config.CompositeKeySeparator)
+		if len(keys) == components && !slices.Contains(choices, keys[position]) {
+			choices = append(choices, keys[position])
+		}
+	}
+
+	sort.Strings(choices)
+
+	return choices
+}
+
+func (s *Service) promptFlag(dynamicFlag config.DynamicFlag, choices []string) (*entity.DynamicFlagValue, error) {
+	message := dynamicFlag.Name
+	if dynamicFlag.Description != "" {
+		message = dynamicFlag.Name + " (" + dynamicFlag.Description + ")"
+	}
+
+	switch dynamicFlag.Type {
+	case entity.String:
+		var (
+			value string
+			err   error
+		)
+
+		if len(choices) != 0 {
+			value, err = s.terminalService.Select(message, choices)
+		} else {
+			value, err = s.terminalService.Input(message)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read value")
+		}
+
+		return &entity.DynamicFlagValue{Name: dynamicFlag.Name, Type: dynamicFlag.Type, Value: &value}, nil
+	case entity.Array:
+		var values []string
+
+		if len(choices) != 0 {
+			selected, err := s.terminalService.MultiSelect(message, choices)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read values")
+			}
+
+			values = selected
+		} else {
+			input, err := s.terminalService.Input(message + ", comma separated")
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read values")
+			}
+
+			for _, value := range strings.Split(input, ",") {
+				if value = strings.TrimSpace(value); value != "" {
+					values = append(values, value)
+				}
+			}
+		}
+
+		return &entity.DynamicFlagValue{
+			Name:      dynamicFlag.Name,
+			Type:      dynamicFlag.Type,
+			Value:     &values,
+			Separator: dynamicFlag.Separator,
+		}, nil
+	case entity.Map:
+		input, err := s.terminalService.Input(message + ", key=value pairs comma separated")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read values")
+		}
+
+		values := make(map[string]string)
+		for _, pair := range strings.Split(input, ",") {
+			key, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+			if !found || key == "" {
+				return nil, errors.Errorf("%s must be formatted as key=value", pair)
+			}
+
+			values[key] = value
+		}
+
+		return &entity.DynamicFlagValue{
+			Name:      dynamicFlag.Name,
+			Type:      dynamicFlag.Type,
+			Value:     &values,
+			Separator: dynamicFlag.Separator,
+		}, nil
+	default:
+		return nil, errors.Errorf("unknown flag type %s", dynamicFlag.Type)
+	}
+}
+
+func isPattern(name string) bool {
+	return strings.HasPrefix(name, config.RegexArgPrefix) || strings.ContainsAny(name, "*?")
+}
+
+func isPredefinedFlag(operation config.Operation, name string) bool {
+	for _, predefinedFlag := range operation.PredefinedFlags {
+		if predefinedFlag.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,287 @@
+package prompt
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/service/flag/prompt/mocks"
+	"project-helper/internal/service/tag/extractor"
+	"project-helper/internal/service/tag/reference"
+	"project-helper/internal/utils"
+)
+
+func TestPromptMissingFlags(t *testing.T) {
+	t.Parallel()
+
+	var (
+		applicationConfig = &config.Application{
+			DynamicFlags: config.DynamicFlags{
+				{Name: "env", Type: entity.String, Description: "environment"},
+				{Name: "services", Type: entity.Array},
+				{Name: "name", Type: entity.String},
+				{Name: "region", Type: entity.String},
+			},
+			Vars: config.Vars{{Name: "image", Value: "app:${{env}}"}},
+			PredefinedArgs: config.PredefinedArgs{
+				{Name: "target", Args: config.Args{{Name: "*", Values: []string{"--region=${{region}}"}}}},
+			},
+		}
+		predefinedArgs = map[string]config.PredefinedArg{
+			"deploy-args": {
+				Name: "deploy-args",
+				Args: config.Args{
+					{Name: "prod", Values: []string{"--prod"}},
+					{Name: "dev", Values: []string{"--dev"}},
+					{Name: "*", Values: []string{"--common"}},
+				},
+			},
+			"clusters": {
+				Name: "clusters",
+				Args: config.Args{
+					{Name: "prod+eu", Values: []string{"--cluster=prod-eu"}},
+					{Name: "prod+us", Values: []string{"--cluster=prod-us"}},
+					{Name: "dev+*", Values: []string{"--cluster=dev"}},
+				},
+			},
+			"services": {
+				Name: "services",
+				Args: config.Args{
+					{Name: "api", Values: []string{"api-service"}},
+					{Name: "web", Values: []string{"web-service"}},
+				},
+			},
+		}
+	)
+
+	tests := map[string]struct {
+		preconditions  func(*testController)
+		flags          *entity.Flags
+		operation      config.Operation
+		expectedValues map[string]any
+		expectedErr    error
+	}{
+		"select from predefined args tag": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(predefinedArgs))
+				t.terminalService.EXPECT().Select("env (environment)", []string{"dev", "prod"}).Return("prod", nil)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env": {Name: "env", Type: entity.String, Value: utils.MakePointer("")},
+				},
+			},
+			operation: config.Operation{
+				Name:              "deploy",
+				PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env", Value: "deploy-args"},
+			},
+			expectedValues: map[string]any{"env": utils.MakePointer("prod")},
+		},
+		"select from composite predefined args tag": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(predefinedArgs)).Times(2)
+				t.terminalService.EXPECT().Select("env (environment)", []string{"prod"}).Return("prod", nil)
+				t.terminalService.EXPECT().Select("region", []string{"eu", "us"}).Return("eu", nil)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{},
+			},
+			operation: config.Operation{
+				Name: "deploy",
+				PredefinedArgsTags: []config.PredefinedArgsTag{
+					{Name: "env+region", Value: "clusters"},
+				},
+			},
+			expectedValues: map[string]any{"env": utils.MakePointer("prod"), "region": utils.MakePointer("eu")},
+		},
+		"multi select for array flag": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(predefinedArgs))
+				t.terminalService.EXPECT().MultiSelect("services", []string{"api", "web"}).Return([]string{"api", "web"}, nil)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"services": {Name: "services", Type: entity.Array, Value: &[]string{}},
+				},
+			},
+			operation: config.Operation{
+				Name: "up",
+				Args: []string{"up", "${{services}}"},
+			},
+			expectedValues: map[string]any{"services": &[]string{"api", "web"}},
+		},
+		"free input in run before operation": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(predefinedArgs))
+				t.terminalService.EXPECT().Input("name").Return("value", nil)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"name": {Name: "name", Type: entity.String, Value: utils.MakePointer("")},
+				},
+			},
+			operation: config.Operation{
+				Name: "operation",
+				RunBefore: config.Operations{
+					{Name: "before", Args: []string{"${{name}}", "${{application-path}}"}},
+				},
+			},
+			expectedValues: map[string]any{"name": utils.MakePointer("value")},
+		},
+		"flags used through vars, predefined args and execution path": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(predefinedArgs)).Times(3)
+				t.terminalService.EXPECT().Select("env (environment)", []string{"dev", "prod"}).Return("dev", nil)
+				t.terminalService.EXPECT().Input("region").Return("eu", nil)
+				t.terminalService.EXPECT().Input("name").Return("api", nil)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{},
+			},
+			operation: config.Operation{
+				Name:              "deploy",
+				Args:              []string{"${{image}}", "${{target}}"},
+				ExecutionPath:     "${{name}}",
+				PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env", Value: "deploy-args"},
+			},
+			expectedValues: map[string]any{
+				"env":    utils.MakePointer("dev"),
+				"region": utils.MakePointer("eu"),
+				"name":   utils.MakePointer("api"),
+			},
+		},
+		"provided and predefined flags are not prompted": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env":  {Name: "env", Type: entity.String, Value: utils.MakePointer("dev")},
+					"name": {Name: "name", Type: entity.String, Value: utils.MakePointer("")},
+				},
+			},
+			operation: config.Operation{
+				Name:            "operation",
+				Args:            []string{"${{env}}", "${{name}}", `${{services | default "api"}}`, "${{env.HOME}}"},
+				PredefinedFlags: config.PredefinedFlags{{Name: "name", Value: "predefined"}},
+			},
+			expectedValues: map[string]any{"env": utils.MakePointer("dev"), "name": utils.MakePointer("")},
+		},
+		"env variable tag is not prompted": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env": {Name: "env", Type: entity.String, Value: utils.MakePointer("")},
+				},
+			},
+			operation: config.Operation{
+				Name: "operation",
+				Args: []string{"${{env.HOME}}"},
+			},
+			expectedValues: map[string]any{"env": utils.MakePointer("")},
+		},
+		"not interactive": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(false)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env": {Name: "env", Type: entity.String, Value: utils.MakePointer("")},
+				},
+			},
+			operation: config.Operation{
+				Name: "operation",
+				Args: []string{"${{env}}"},
+			},
+			expectedValues: map[string]any{"env": utils.MakePointer("")},
+		},
+		"with error on select": {
+			preconditions: func(t *testController) {
+				t.terminalService.EXPECT().IsInteractive().Return(true)
+				t.configService.EXPECT().GetConfig().Return(applicationConfig)
+				t.sourceService.EXPECT().GetPredefinedArg(gomock.Any()).DoAndReturn(getPredefinedArg(predefinedArgs))
+				t.terminalService.EXPECT().Select(gomock.Any(), gomock.Any()).Return("", assert.AnError)
+			},
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{},
+			},
+			operation: config.Operation{
+				Name:              "deploy",
+				PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env", Value: "deploy-args"},
+			},
+			expectedErr: errors.New("failed to prompt flag env: failed to read value: assert.AnError general error for testing"),
+		},
+		"nil flags": {
+			expectedErr: errors.New("nil input"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			err := controller.Build().PromptMissingFlags(testCase.flags, testCase.operation)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+
+				for flagName, expectedValue := range testCase.expectedValues {
+					assert.Equal(t, expectedValue, testCase.flags.DynamicFlags[flagName].Value)
+				}
+			}
+		})
+	}
+}
+
+func getPredefinedArg(predefinedArgs map[string]config.PredefinedArg) func(string) (config.PredefinedArg, bool, error) {
+	return func(name string) (config.PredefinedArg, bool, error) {
+		predefinedArg, ok := predefinedArgs[name]
+
+		return predefinedArg, ok, nil
+	}
+}
+
+type testController struct {
+	configService   *mocks.MockConfigService
+	sourceService   *mocks.MockSourceService
+	terminalService *mocks.MockTerminalService
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService:   mocks.NewMockConfigService(ctrl),
+		sourceService:   mocks.NewMockSourceService(ctrl),
+		terminalService: mocks.NewMockTerminalService(ctrl),
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(t.configService, t.sourceService, reference.NewService(extractor.NewService()), t.terminalService)
+}
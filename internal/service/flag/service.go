@@ -1,6 +1,8 @@
 package flag
 
 import (
+	"sync"
+
 	"project-helper/internal/config"
 	"project-helper/internal/domain/entity"
 	"project-helper/internal/utils"
@@ -9,6 +11,7 @@ import (
 type Service struct {
 	initialFlags   *entity.Flags
 	operationFlags map[string]*entity.Flags
+	mutex          sync.Mutex
 }
 
 func NewFlagsService(initialFlags *entity.Flags) *Service {
@@ -23,6 +26,9 @@ func (s *Service) GetInitialFlags() *entity.Flags {
 }
 
 func (s *Service) GetOperationFlags(operation config.Operation) *entity.Flags {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	if s.operationFlags[operation.Name] == nil {
 		s.operationFlags[operation.Name] = s.enhanceFlags(operation.PredefinedFlags)
 	}
@@ -35,6 +41,11 @@ func (s *Service) enhanceFlags(operationPredefinedFlags config.PredefinedFlags)
 	}
 
 	newFlags := *s.initialFlags
+	newFlags.DynamicFlags = make(map[string]*entity.DynamicFlagValue, len(s.initialFlags.DynamicFlags)+len(operationPredefinedFlags))
+
+	for name, value := range s.initialFlags.DynamicFlags {
+		newFlags.DynamicFlags[name] = value
+	}
 
 	for _, flag := range operationPredefinedFlags {
 		newFlags.DynamicFlags[flag.Name] = &entity.DynamicFlagValue{
@@ -144,3 +144,27 @@ func TestFlagsServiceGetOperationFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestFlagsServiceGetOperationFlagsKeepsInitialFlags(t *testing.T) {
+	t.Parallel()
+
+	initialFlags := &entity.Flags{
+		DynamicFlags: map[string]*entity.DynamicFlagValue{
+			"flag": {
+				Value: utils.MakePointer("value"),
+				Type:  entity.String,
+				Name:  "flag",
+			},
+		},
+	}
+
+	service := NewFlagsService(initialFlags)
+
+	flags := service.GetOperationFlags(config.Operation{
+		Name:            "operation",
+		PredefinedFlags: config.PredefinedFlags{{Name: "flag", Value: "predefined_value"}},
+	})
+
+	assert.Equal(t, "predefined_value", flags.GetFlagStringValue("flag"))
+	assert.Equal(t, "value", service.GetInitialFlags().GetFlagStringValue("flag"))
+}
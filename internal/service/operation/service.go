@@ -49,15 +49,13 @@ func (s *Service) GetEnhancedOperation(ctx context.Context, name string) (config
 	return operation, nil
 }
 
-func (s *Service) GetOperationExecutionPath(ctx context.Context, name string) (string, error) {
-	operation, err := s.configService.GetOperation(ctx, name)
-	if err != nil {
-		return "", errors.Wrap(err, "failed to get operation")
+func (s *Service) GetOperationExecutionPath(_ context.Context, operation config.Operation) (string, error) {
+	executionPath := operation.ExecutionPath
+	if !filepath.IsAbs(executionPath) {
+		executionPath = filepath.Join(s.configService.GetApplicationPath(), executionPath)
 	}
 
-	executionPath := filepath.Join(s.configService.GetApplicationPath(), operation.ExecutionPath)
-
-	_, err = os.Stat(executionPath)
+	_, err := os.Stat(executionPath)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to get operation execution path")
 	}
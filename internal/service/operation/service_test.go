@@ -131,7 +131,7 @@ func TestGetOperationExecutionPath(t *testing.T) {
 
 	tests := map[string]struct {
 		preconditions func(*testing.T, *testController)
-		name          string
+		operation     config.Operation
 		output        string
 		expectedErr   error
 	}{
@@ -141,37 +141,33 @@ func TestGetOperationExecutionPath(t *testing.T) {
 				_, err := os.Create(filepath.Join(dir, "operation-path"))
 				require.NoError(t, err)
 
-				controller.configService.EXPECT().GetOperation(gomock.Any(), "operation").
-					Return(config.Operation{
-						Name:          "operation",
-						ExecutionPath: "operation-path",
-					}, nil)
 				controller.configService.EXPECT().GetApplicationPath().
 					Return(dir)
 			},
-			name:   "operation",
+			operation: config.Operation{
+				Name:          "operation",
+				ExecutionPath: "operation-path",
+			},
 			output: "operation-path",
 		},
+		"with absolute path": {
+			preconditions: func(*testing.T, *testController) {},
+			operation: config.Operation{
+				Name:          "operation",
+				ExecutionPath: os.TempDir(),
+			},
+			output: os.TempDir(),
+		},
 		"with file not found": {
 			preconditions: func(t *testing.T, controller *testController) {
-				controller.configService.EXPECT().GetOperation(gomock.Any(), "operation").
-					Return(config.Operation{
-						Name:          "operation",
-						ExecutionPath: "operation-path",
-					}, nil)
 				controller.configService.EXPECT().GetApplicationPath().
 					Return("application-path")
 			},
-			name:        "operation",
-			expectedErr: errors.New("failed to get operation execution path: stat application-path/operation-path: no such file or directory"),
-		},
-		"with operation not found": {
-			preconditions: func(t *testing.T, controller *testController) {
-				controller.configService.EXPECT().GetOperation(gomock.Any(), "operation").
-					Return(config.Operation{}, assert.AnError)
+			operation: config.Operation{
+				Name:          "operation",
+				ExecutionPath: "operation-path",
 			},
-			name:        "operation",
-			expectedErr: errors.New("failed to get operation: assert.AnError general error for testing"),
+			expectedErr: errors.New("failed to get operation execution path: stat application-path/operation-path: no such file or directory"),
 		},
 	}
 
@@ -186,7 +182,7 @@ func TestGetOperationExecutionPath(t *testing.T) {
 
 			service := controller.Build()
 
-			executionPath, err := service.GetOperationExecutionPath(context.Background(), testCase.name)
+			executionPath, err := service.GetOperationExecutionPath(context.Background(), testCase.operation)
 
 			if testCase.expectedErr != nil {
 				require.Error(t, err)
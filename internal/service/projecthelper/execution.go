@@ -0,0 +1,62 @@
+package projecthelper
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"project-helper/internal/config"
+)
+
+type execution struct {
+	done chan struct{}
+	err  error
+}
+
+type executions struct {
+	mutex sync.Mutex
+	items map[string]*execution
+}
+
+func newExecutions() *executions {
+	return &executions{
+		items: make(map[string]*execution),
+	}
+}
+
+// start returns the execution registered for the key and reports whether the caller owns it and has to run it.
+func (e *executions) start(key string) (*execution, bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if existing, ok := e.items[key]; ok {
+		return existing, false
+	}
+
+	created := &execution{done: make(chan struct{})}
+	e.items[key] = created
+
+	return created, true
+}
+
+func (e *execution) finish(err error) {
+	e.err = err
+	close(e.done)
+}
+
+func (e *execution) wait() error {
+	<-e.done
+
+	return e.err
+}
+
+func getExecutionKey(operation config.Operation) string {
+	predefinedFlags := make([]string, len(operation.PredefinedFlags))
+	for i, predefinedFlag := range operation.PredefinedFlags {
+		predefinedFlags[i] = predefinedFlag.Name + "=" + predefinedFlag.Value
+	}
+
+	sort.Strings(predefinedFlags)
+
+	return operation.Name + "\x00" + strings.Join(predefinedFlags, "\x00")
+}
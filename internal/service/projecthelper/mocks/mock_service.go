@@ -55,6 +55,21 @@ func (mr *MockArgServiceMockRecorder) PrepareArgs(ctx, operation any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareArgs", reflect.TypeOf((*MockArgService)(nil).PrepareArgs), ctx, operation)
 }
 
+// PrepareExecutionPath mocks base method.
+func (m *MockArgService) PrepareExecutionPath(ctx context.Context, operation config.Operation) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareExecutionPath", ctx, operation)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareExecutionPath indicates an expected call of PrepareExecutionPath.
+func (mr *MockArgServiceMockRecorder) PrepareExecutionPath(ctx, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareExecutionPath", reflect.TypeOf((*MockArgService)(nil).PrepareExecutionPath), ctx, operation)
+}
+
 // MockOperationService is a mock of OperationService interface.
 type MockOperationService struct {
 	ctrl     *gomock.Controller
@@ -94,18 +109,18 @@ func (mr *MockOperationServiceMockRecorder) GetEnhancedOperation(ctx, name any)
 }
 
 // GetOperationExecutionPath mocks base method.
-func (m *MockOperationService) GetOperationExecutionPath(ctx context.Context, name string) (string, error) {
+func (m *MockOperationService) GetOperationExecutionPath(ctx context.Context, operation config.Operation) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOperationExecutionPath", ctx, name)
+	ret := m.ctrl.Call(m, "GetOperationExecutionPath", ctx, operation)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOperationExecutionPath indicates an expected call of GetOperationExecutionPath.
-func (mr *MockOperationServiceMockRecorder) GetOperationExecutionPath(ctx, name any) *gomock.Call {
+func (mr *MockOperationServiceMockRecorder) GetOperationExecutionPath(ctx, operation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationExecutionPath", reflect.TypeOf((*MockOperationService)(nil).GetOperationExecutionPath), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationExecutionPath", reflect.TypeOf((*MockOperationService)(nil).GetOperationExecutionPath), ctx, operation)
 }
 
 // MockFlagService is a mock of FlagService interface.
@@ -144,3 +159,129 @@ func (mr *MockFlagServiceMockRecorder) GetInitialFlags() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInitialFlags", reflect.TypeOf((*MockFlagService)(nil).GetInitialFlags))
 }
+
+// MockStickyFlagService is a mock of StickyFlagService interface.
+type MockStickyFlagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStickyFlagServiceMockRecorder
+}
+
+// MockStickyFlagServiceMockRecorder is the mock recorder for MockStickyFlagService.
+type MockStickyFlagServiceMockRecorder struct {
+	mock *MockStickyFlagService
+}
+
+// NewMockStickyFlagService creates a new mock instance.
+func NewMockStickyFlagService(ctrl *gomock.Controller) *MockStickyFlagService {
+	mock := &MockStickyFlagService{ctrl: ctrl}
+	mock.recorder = &MockStickyFlagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStickyFlagService) EXPECT() *MockStickyFlagServiceMockRecorder {
+	return m.recorder
+}
+
+// SaveStickyFlags mocks base method.
+func (m *MockStickyFlagService) SaveStickyFlags() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStickyFlags")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStickyFlags indicates an expected call of SaveStickyFlags.
+func (mr *MockStickyFlagServiceMockRecorder) SaveStickyFlags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStickyFlags", reflect.TypeOf((*MockStickyFlagService)(nil).SaveStickyFlags))
+}
+
+// MockPromptService is a mock of PromptService interface.
+type MockPromptService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromptServiceMockRecorder
+}
+
+// MockPromptServiceMockRecorder is the mock recorder for MockPromptService.
+type MockPromptServiceMockRecorder struct {
+	mock *MockPromptService
+}
+
+// NewMockPromptService creates a new mock instance.
+func NewMockPromptService(ctrl *gomock.Controller) *MockPromptService {
+	mock := &MockPromptService{ctrl: ctrl}
+	mock.recorder = &MockPromptServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromptService) EXPECT() *MockPromptServiceMockRecorder {
+	return m.recorder
+}
+
+// PromptMissingFlags mocks base method.
+func (m *MockPromptService) PromptMissingFlags(flags *entity.Flags, operation config.Operation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromptMissingFlags", flags, operation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PromptMissingFlags indicates an expected call of PromptMissingFlags.
+func (mr *MockPromptServiceMockRecorder) PromptMissingFlags(flags, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromptMissingFlags", reflect.TypeOf((*MockPromptService)(nil).PromptMissingFlags), flags, operation)
+}
+
+// MockExtractorService is a mock of ExtractorService interface.
+type MockExtractorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtractorServiceMockRecorder
+}
+
+// MockExtractorServiceMockRecorder is the mock recorder for MockExtractorService.
+type MockExtractorServiceMockRecorder struct {
+	mock *MockExtractorService
+}
+
+// NewMockExtractorService creates a new mock instance.
+func NewMockExtractorService(ctrl *gomock.Controller) *MockExtractorService {
+	mock := &MockExtractorService{ctrl: ctrl}
+	mock.recorder = &MockExtractorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtractorService) EXPECT() *MockExtractorServiceMockRecorder {
+	return m.recorder
+}
+
+// ExtractTags mocks base method.
+func (m *MockExtractorService) ExtractTags(arg entity.Arg) entity.Tags {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTags", arg)
+	ret0, _ := ret[0].(entity.Tags)
+	return ret0
+}
+
+// ExtractTags indicates an expected call of ExtractTags.
+func (mr *MockExtractorServiceMockRecorder) ExtractTags(arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTags", reflect.TypeOf((*MockExtractorService)(nil).ExtractTags), arg)
+}
+
+// ParseTag mocks base method.
+func (m *MockExtractorService) ParseTag(tag entity.Tag) (*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTag", tag)
+	ret0, _ := ret[0].(*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTag indicates an expected call of ParseTag.
+func (mr *MockExtractorServiceMockRecorder) ParseTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTag", reflect.TypeOf((*MockExtractorService)(nil).ParseTag), tag)
+}
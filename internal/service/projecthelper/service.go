@@ -4,8 +4,12 @@ package projecthelper
 
 import (
 	"context"
+	stderrors "errors"
 	"os"
 	"os/exec"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -13,55 +17,191 @@ import (
 	"project-helper/internal/domain/entity"
 )
 
+// commandWaitDelay is how long an interrupted command may take to stop before it is killed
+const commandWaitDelay = 10 * time.Second
+
 type (
 	ArgService interface {
 		PrepareArgs(ctx context.Context, operation config.Operation) ([]string, error)
+		PrepareExecutionPath(ctx context.Context, operation config.Operation) (string, error)
 	}
 	OperationService interface {
 		GetEnhancedOperation(ctx context.Context, name string) (config.Operation, error)
-		GetOperationExecutionPath(ctx context.Context, name string) (string, error)
+		GetOperationExecutionPath(ctx context.Context, operation config.Operation) (string, error)
 	}
 	FlagService interface {
 		GetInitialFlags() *entity.Flags
 	}
+	StickyFlagService interface {
+		SaveStickyFlags() error
+	}
+	PromptService interface {
+		PromptMissingFlags(flags *entity.Flags, operation config.Operation) error
+	}
+	ExtractorService interface {
+		ExtractTags(arg entity.Arg) entity.Tags
+		ParseTag(tag entity.Tag) (*entity.TagExpression, error)
+	}
 )
 
 type Service struct {
-	operationService OperationService
-	flagService      FlagService
-	argService       ArgService
+	operationService  OperationService
+	flagService       FlagService
+	stickyFlagService StickyFlagService
+	argService        ArgService
+	promptService     PromptService
+	extractorService  ExtractorService
 }
 
-func NewService(operationService OperationService, flagService FlagService, argService ArgService) *Service {
+func NewService(
+	operationService OperationService,
+	flagService FlagService,
+	stickyFlagService StickyFlagService,
+	argService ArgService,
+	promptService PromptService,
+	extractorService ExtractorService,
+) *Service {
 	return &Service{
-		operationService: operationService,
-		flagService:      flagService,
-		argService:       argService,
+		operationService:  operationService,
+		flagService:       flagService,
+		stickyFlagService: stickyFlagService,
+		argService:        argService,
+		promptService:     promptService,
+		extractorService:  extractorService,
 	}
 }
 
 func (s *Service) Run(ctx context.Context) error {
 	flags := s.flagService.GetInitialFlags()
 
-	enhancedOperation, err := s.operationService.GetEnhancedOperation(ctx, flags.Operation)
-	if err != nil {
-		return errors.Wrap(err, "failed to get enhanced operation")
+	operations := make(config.Operations, 0, len(flags.Operations))
+
+	for _, name := range flags.Operations {
+		enhancedOperation, err := s.operationService.GetEnhancedOperation(ctx, name)
+		if err != nil {
+			return errors.Wrap(err, "failed to get enhanced operation")
+		}
+
+		if err = s.promptService.PromptMissingFlags(flags, enhancedOperation); err != nil {
+			return errors.Wrap(err, "failed to prompt missing flags")
+		}
+
+		operations = append(operations, enhancedOperation)
+	}
+
+	// sticky values of a run that couldn't start must not become the next defaults
+	if err := s.stickyFlagService.SaveStickyFlags(); err != nil {
+		return errors.Wrap(err, "failed to save sticky flags")
+	}
+
+	if flags.Parallel {
+		return s.runParallel(ctx, operations, flags.PassThroughArgs)
 	}
 
-	return s.runOperation(ctx, enhancedOperation)
+	runExecutions := newExecutions()
+
+	for i, operation := range operations {
+		if err := s.runOperation(ctx, runExecutions, nil, operation, getPassThroughArgs(operations, i, flags.PassThroughArgs)); err != nil {
+			return errors.Wrapf(err, "failed to run operation %s", operation.Name)
+		}
+	}
+
+	return nil
 }
 
-func (s *Service) runOperation(ctx context.Context, operation config.Operation) error {
-	err := s.runBefore(ctx, operation)
+func (s *Service) runParallel(ctx context.Context, operations config.Operations, passThroughArgs []string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		waitGroup     sync.WaitGroup
+		runExecutions = newExecutions()
+		runErrors     = make([]error, len(operations))
+	)
+
+	for i, operation := range operations {
+		waitGroup.Add(1)
+
+		go func(i int, operation config.Operation) {
+			defer waitGroup.Done()
+
+			if err := s.runOperation(ctx, runExecutions, nil, operation, getPassThroughArgs(operations, i, passThroughArgs)); err != nil {
+				runErrors[i] = errors.Wrapf(err, "failed to run operation %s", operation.Name)
+
+				cancel()
+			}
+		}(i, operation)
+	}
+
+	waitGroup.Wait()
+
+	return stderrors.Join(runErrors...)
+}
+
+// getPassThroughArgs returns the pass-through args for the operation at index
+// i. They belong to the main operation, the last one of a chain.
+func getPassThroughArgs(operations config.Operations, i int, passThroughArgs []string) []string {
+	if i != len(operations)-1 {
+		return nil
+	}
+
+	return passThroughArgs
+}
+
+func (s *Service) runOperation(
+	ctx context.Context,
+	runExecutions *executions,
+	parents []string,
+	operation config.Operation,
+	passThroughArgs []string,
+) error {
+	key := getExecutionKey(operation)
+	if slices.Contains(parents, key) {
+		return errors.Errorf("operation %s depends on itself", operation.Name)
+	}
+
+	operationExecution, owner := runExecutions.start(key)
+	if !owner {
+		log.Debug().Str("operation.name", operation.Name).Msg("Operation already executed in this run")
+
+		return operationExecution.wait()
+	}
+
+	err := s.executeOperation(ctx, runExecutions, append(slices.Clip(parents), key), operation, passThroughArgs)
+
+	operationExecution.finish(err)
+
+	return err
+}
+
+func (s *Service) executeOperation(
+	ctx context.Context,
+	runExecutions *executions,
+	parents []string,
+	operation config.Operation,
+	passThroughArgs []string,
+) error {
+	err := s.runBefore(ctx, runExecutions, parents, operation)
 	if err != nil {
 		return errors.Wrap(err, "failed to run before")
 	}
 
+	if operation.ChangePath {
+		operation.ExecutionPath, err = s.argService.PrepareExecutionPath(ctx, operation)
+		if err != nil {
+			return errors.Wrap(err, "failed to prepare execution path")
+		}
+	}
+
 	args, err := s.argService.PrepareArgs(ctx, operation)
 	if err != nil {
 		return errors.Wrap(err, "failed to prepare args")
 	}
 
+	if len(passThroughArgs) != 0 && operation.ShouldAppendPassThroughArgs(s.usesPassThroughArgs) {
+		args = append(args, passThroughArgs...)
+	}
+
 	log.Debug().
 		Str("operation.description", operation.Description).
 		Strs("operation.args.raw", args).
@@ -76,9 +216,22 @@ func (s *Service) runOperation(ctx context.Context, operation config.Operation)
 	return nil
 }
 
-func (s *Service) runBefore(ctx context.Context, operation config.Operation) error {
+// usesPassThroughArgs reports whether the arg has an args tag, plain, splat
+// or embedded in a value.
+func (s *Service) usesPassThroughArgs(arg string) bool {
+	for _, tag := range s.extractorService.ExtractTags(entity.Arg(arg)) {
+		tagExpression, err := s.extractorService.ParseTag(tag)
+		if err == nil && tagExpression.Name == entity.PassThroughArgsTag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Service) runBefore(ctx context.Context, runExecutions *executions, parents []string, operation config.Operation) error {
 	for _, runBeforeOperation := range operation.RunBefore {
-		err := s.runOperation(ctx, runBeforeOperation)
+		err := s.runOperation(ctx, runExecutions, parents, runBeforeOperation, nil)
 		if err != nil {
 			return errors.Wrapf(err, "failed to run before operation: %s", runBeforeOperation.Name)
 		}
@@ -89,8 +242,14 @@ func (s *Service) runBefore(ctx context.Context, operation config.Operation) err
 
 func (s *Service) runCmd(ctx context.Context, operation config.Operation, finalArgs []string) error {
 	command := exec.CommandContext(ctx, operation.Cmd, finalArgs...)
+	// a cancelled command is interrupted first, so it can clean up before it is killed
+	command.Cancel = func() error {
+		return command.Process.Signal(os.Interrupt)
+	}
+	command.WaitDelay = commandWaitDelay
+
 	if operation.ChangePath {
-		executionPath, err := s.operationService.GetOperationExecutionPath(ctx, operation.Name)
+		executionPath, err := s.operationService.GetOperationExecutionPath(ctx, operation)
 		if err != nil {
 			return errors.Wrap(err, "failed to get operation execution path")
 		}
@@ -100,7 +259,7 @@ func (s *Service) runCmd(ctx context.Context, operation config.Operation, finalA
 
 	log.Debug().Msgf("Command execution: %s", command.String())
 
-	command.Env = append(os.Environ())
+	command.Env = os.Environ()
 
 	command.Stdout = os.Stdout
 	command.Stderr = os.Stderr
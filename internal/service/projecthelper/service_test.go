@@ -10,6 +10,8 @@ import (
 	"project-helper/internal/config"
 	"project-helper/internal/domain/entity"
 	"project-helper/internal/service/projecthelper/mocks"
+	"project-helper/internal/service/tag/extractor"
+	"project-helper/internal/utils"
 )
 
 func TestRun(t *testing.T) {
@@ -25,41 +27,229 @@ func TestRun(t *testing.T) {
 			preconditions: func(t *testController) {
 				t.flagService.EXPECT().GetInitialFlags().
 					Return(&entity.Flags{
-						Operation: "operation",
+						Operations: []string{"operation"},
 					})
 				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
 					Return(config.Operation{
 						Name: "operation",
 						Cmd:  "echo",
 						RunBefore: config.Operations{{
-							Name:       "before",
-							Cmd:        "echo",
-							ChangePath: true,
+							Name:          "before",
+							Cmd:           "echo",
+							ChangePath:    true,
+							ExecutionPath: "${{env.SERVICE_DIR}}",
 						}},
 					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareExecutionPath(gomock.Any(), config.Operation{
+					Name:          "before",
+					Cmd:           "echo",
+					ChangePath:    true,
+					ExecutionPath: "${{env.SERVICE_DIR}}",
+				}).Return("service", nil)
 				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{
-					Name:       "before",
-					Cmd:        "echo",
-					ChangePath: true,
+					Name:          "before",
+					Cmd:           "echo",
+					ChangePath:    true,
+					ExecutionPath: "service",
 				}).Return([]string{"'Hello, World! before'"}, nil)
-				t.operationService.EXPECT().GetOperationExecutionPath(gomock.Any(), "before").
-					Return(dir, nil)
+				t.operationService.EXPECT().GetOperationExecutionPath(gomock.Any(), config.Operation{
+					Name:          "before",
+					Cmd:           "echo",
+					ChangePath:    true,
+					ExecutionPath: "service",
+				}).Return(dir, nil)
 				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{
 					Name: "operation",
 					Cmd:  "echo",
 					RunBefore: config.Operations{{
-						Name:       "before",
-						Cmd:        "echo",
-						ChangePath: true,
+						Name:          "before",
+						Cmd:           "echo",
+						ChangePath:    true,
+						ExecutionPath: "${{env.SERVICE_DIR}}",
 					}},
 				}).Return([]string{"'Hello, World!'"}, nil)
 			},
 		},
+		"success with multiple operations and shared run before": {
+			preconditions: func(t *testController) {
+				before := config.Operation{Name: "before", Cmd: "echo"}
+
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations: []string{"build", "test"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "build").
+					Return(config.Operation{Name: "build", Cmd: "echo", RunBefore: config.Operations{before}}, nil)
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "test").
+					Return(config.Operation{Name: "test", Cmd: "echo", RunBefore: config.Operations{before}}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), before).Return([]string{"before"}, nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), gomock.Any()).Return([]string{"operation"}, nil).Times(2)
+			},
+		},
+		"success with parallel operations and shared run before": {
+			preconditions: func(t *testController) {
+				before := config.Operation{Name: "before", Cmd: "echo"}
+
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations: []string{"build", "test"},
+						Parallel:   true,
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "build").
+					Return(config.Operation{Name: "build", Cmd: "echo", RunBefore: config.Operations{before}}, nil)
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "test").
+					Return(config.Operation{Name: "test", Cmd: "echo", RunBefore: config.Operations{before}}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), before).Return([]string{"before"}, nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), gomock.Any()).Return([]string{"operation"}, nil).Times(2)
+			},
+		},
+		"with error on parallel operations": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations: []string{"build", "test"},
+						Parallel:   true,
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "build").
+					Return(config.Operation{Name: "build", Cmd: "echo"}, nil)
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "test").
+					Return(config.Operation{Name: "test", Cmd: "echo"}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{Name: "build", Cmd: "echo"}).
+					Return([]string{"build"}, nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{Name: "test", Cmd: "echo"}).
+					Return(nil, assert.AnError)
+			},
+			expectedErr: errors.New("failed to run operation test: failed to prepare args: assert.AnError general error for testing"),
+		},
+		"with operation depending on itself": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations: []string{"operation"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name:      "operation",
+						Cmd:       "echo",
+						RunBefore: config.Operations{{Name: "operation", Cmd: "echo"}},
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+			},
+			expectedErr: errors.New("failed to run before operation: operation: operation operation depends on itself"),
+		},
+		"success with pass through args": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations:      []string{"operation"},
+						PassThroughArgs: []string{"value"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name: "operation",
+						Cmd:  "test",
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{
+					Name: "operation",
+					Cmd:  "test",
+				}).Return([]string{"value", "="}, nil)
+			},
+		},
+		"success with pass through args of a chain": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations:      []string{"build", "operation"},
+						PassThroughArgs: []string{"value"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "build").
+					Return(config.Operation{Name: "build", Cmd: "test"}, nil)
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{Name: "operation", Cmd: "test"}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				// "test value value" fails, so the args must not reach build
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{Name: "build", Cmd: "test"}).
+					Return([]string{"value"}, nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{Name: "operation", Cmd: "test"}).
+					Return([]string{"value", "="}, nil)
+			},
+		},
+		"with pass through args used by a splat tag": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations:      []string{"operation"},
+						PassThroughArgs: []string{"value"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name: "operation",
+						Cmd:  "test",
+						Args: []string{"value", "=", "${{args...}}"},
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), gomock.Any()).Return([]string{"value", "=", "value"}, nil)
+			},
+		},
+		"with pass through args used by an embedded tag": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations:      []string{"operation"},
+						PassThroughArgs: []string{"value"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name: "operation",
+						Cmd:  "test",
+						Args: []string{"--value", "=", "--${{args | default \"none\"}}"},
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), gomock.Any()).Return([]string{"--value", "=", "--value"}, nil)
+			},
+		},
+		"with pass through args not appended": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations:      []string{"operation"},
+						PassThroughArgs: []string{"value"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name:                  "operation",
+						Cmd:                   "test",
+						AppendPassThroughArgs: utils.MakePointer(false),
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{
+					Name:                  "operation",
+					Cmd:                   "test",
+					AppendPassThroughArgs: utils.MakePointer(false),
+				}).Return([]string{"value", "="}, nil)
+			},
+			expectedErr: errors.New("failed to run command"),
+		},
 		"with error on get operation execution path": {
 			preconditions: func(t *testController) {
 				t.flagService.EXPECT().GetInitialFlags().
 					Return(&entity.Flags{
-						Operation: "operation",
+						Operations: []string{"operation"},
 					})
 				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
 					Return(config.Operation{
@@ -71,27 +261,54 @@ func TestRun(t *testing.T) {
 							ChangePath: true,
 						}},
 					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareExecutionPath(gomock.Any(), gomock.Any()).Return("", nil)
 				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{
 					Name:       "before",
 					Cmd:        "echo",
 					ChangePath: true,
 				}).Return([]string{"'Hello, World! before'"}, nil)
-				t.operationService.EXPECT().GetOperationExecutionPath(gomock.Any(), "before").
-					Return("", assert.AnError)
+				t.operationService.EXPECT().GetOperationExecutionPath(gomock.Any(), config.Operation{
+					Name:       "before",
+					Cmd:        "echo",
+					ChangePath: true,
+				}).Return("", assert.AnError)
 			},
 			expectedErr: errors.New("failed to run before: failed to run before operation: before: failed to run command: failed to get operation execution path: assert.AnError general error for testing"),
 		},
+		"with error on prepare execution path": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations: []string{"operation"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name:          "operation",
+						Cmd:           "echo",
+						ChangePath:    true,
+						ExecutionPath: "${{env.MISSING}}",
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
+				t.argService.EXPECT().PrepareExecutionPath(gomock.Any(), gomock.Any()).Return("", assert.AnError)
+			},
+			expectedErr: errors.New("failed to run operation operation: failed to prepare execution path: assert.AnError general error for testing"),
+		},
 		"with error on prepare args": {
 			preconditions: func(t *testController) {
 				t.flagService.EXPECT().GetInitialFlags().
 					Return(&entity.Flags{
-						Operation: "operation",
+						Operations: []string{"operation"},
 					})
 				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
 					Return(config.Operation{
 						Name: "operation",
 						Cmd:  "echo",
 					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(nil)
 				t.argService.EXPECT().PrepareArgs(gomock.Any(), config.Operation{
 					Name: "operation",
 					Cmd:  "echo",
@@ -99,11 +316,42 @@ func TestRun(t *testing.T) {
 			},
 			expectedErr: errors.New("failed to prepare args: assert.AnError general error for testing"),
 		},
+		"with error on prompt missing flags": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations: []string{"operation"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name: "operation",
+						Cmd:  "echo",
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			expectedErr: errors.New("failed to prompt missing flags: assert.AnError general error for testing"),
+		},
+		"with error on save sticky flags": {
+			preconditions: func(t *testController) {
+				t.flagService.EXPECT().GetInitialFlags().
+					Return(&entity.Flags{
+						Operations: []string{"operation"},
+					})
+				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
+					Return(config.Operation{
+						Name: "operation",
+						Cmd:  "echo",
+					}, nil)
+				t.promptService.EXPECT().PromptMissingFlags(gomock.Any(), gomock.Any()).Return(nil)
+				t.stickyFlagService.EXPECT().SaveStickyFlags().Return(assert.AnError)
+			},
+			expectedErr: errors.New("failed to save sticky flags: assert.AnError general error for testing"),
+		},
 		"with error on get enhanced operation": {
 			preconditions: func(t *testController) {
 				t.flagService.EXPECT().GetInitialFlags().
 					Return(&entity.Flags{
-						Operation: "operation",
+						Operations: []string{"operation"},
 					})
 				t.operationService.EXPECT().GetEnhancedOperation(gomock.Any(), "operation").
 					Return(config.Operation{}, assert.AnError)
@@ -134,22 +382,29 @@ func TestRun(t *testing.T) {
 }
 
 type testController struct {
-	argService       *mocks.MockArgService
-	operationService *mocks.MockOperationService
-	flagService      *mocks.MockFlagService
+	argService        *mocks.MockArgService
+	operationService  *mocks.MockOperationService
+	flagService       *mocks.MockFlagService
+	stickyFlagService *mocks.MockStickyFlagService
+	promptService     *mocks.MockPromptService
 }
 
 func newTestController(ctrl *gomock.Controller) *testController {
 	return &testController{
-		flagService:      mocks.NewMockFlagService(ctrl),
-		operationService: mocks.NewMockOperationService(ctrl),
-		argService:       mocks.NewMockArgService(ctrl),
+		flagService:       mocks.NewMockFlagService(ctrl),
+		stickyFlagService: mocks.NewMockStickyFlagService(ctrl),
+		operationService:  mocks.NewMockOperationService(ctrl),
+		argService:        mocks.NewMockArgService(ctrl),
+		promptService:     mocks.NewMockPromptService(ctrl),
 	}
 }
 
 func (t *testController) Build() *Service {
 	return NewService(t.operationService,
 		t.flagService,
+		t.stickyFlagService,
 		t.argService,
+		t.promptService,
+		extractor.NewService(),
 	)
 }
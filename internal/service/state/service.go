@@ -0,0 +1,150 @@
+package state
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	applicationDirectory = "project-helper"
+	stickyFlagsFile      = "sticky-flags.yaml"
+	sessionVarsFile      = "session-vars.yaml"
+	defaultApplication   = "default"
+)
+
+var invalidDirectoryCharacters = regexp.MustCompile("[^a-zA-Z0-9._-]+")
+
+type sessionVars struct {
+	Session string
+	Values  map[string]string
+}
+
+type Service struct {
+	directory string
+}
+
+func NewService(directory string) *Service {
+	return &Service{
+		directory: directory,
+	}
+}
+
+func GetApplicationDirectory(stateHome string, applicationName string) string {
+	name := invalidDirectoryCharacters.ReplaceAllString(applicationName, "-")
+	if name == "" || name == "-" {
+		name = defaultApplication
+	}
+
+	return filepath.Join(stateHome, applicationDirectory, name)
+}
+
+func (s *Service) GetStickyFlags() (map[string][]string, error) {
+	stickyFlags := make(map[string][]string)
+
+	file, err := os.Open(s.getStickyFlagsPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return stickyFlags, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to open sticky flags file")
+	}
+	defer file.Close()
+
+	if err = yaml.NewDecoder(file).Decode(&stickyFlags); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "failed to decode sticky flags file")
+	}
+
+	return stickyFlags, nil
+}
+
+func (s *Service) SaveStickyFlags(stickyFlags map[string][]string) error {
+	if err := os.MkdirAll(s.directory, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create state directory")
+	}
+
+	file, err := os.Create(s.getStickyFlagsPath())
+	if err != nil {
+		return errors.Wrap(err, "failed to create sticky flags file")
+	}
+	defer file.Close()
+
+	if err = yaml.NewEncoder(file).Encode(stickyFlags); err != nil {
+		return errors.Wrap(err, "failed to encode sticky flags file")
+	}
+
+	return nil
+}
+
+func (s *Service) ResetStickyFlags(names ...string) error {
+	if len(names) == 0 {
+		if err := os.Remove(s.getStickyFlagsPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errors.Wrap(err, "failed to remove sticky flags file")
+		}
+
+		return nil
+	}
+
+	stickyFlags, err := s.GetStickyFlags()
+	if err != nil {
+		return errors.Wrap(err, "failed to get sticky flags")
+	}
+
+	for _, name := range names {
+		delete(stickyFlags, name)
+	}
+
+	return s.SaveStickyFlags(stickyFlags)
+}
+
+func (s *Service) GetSessionVars(session string) (map[string]string, error) {
+	values := make(map[string]string)
+
+	file, err := os.Open(filepath.Join(s.directory, sessionVarsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return values, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to open session vars file")
+	}
+	defer file.Close()
+
+	var storedVars sessionVars
+	if err = yaml.NewDecoder(file).Decode(&storedVars); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "failed to decode session vars file")
+	}
+
+	if storedVars.Session != session {
+		return values, nil
+	}
+
+	for name, value := range storedVars.Values {
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+func (s *Service) SaveSessionVars(session string, values map[string]string) error {
+	if err := os.MkdirAll(s.directory, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create state directory")
+	}
+
+	file, err := os.Create(filepath.Join(s.directory, sessionVarsFile))
+	if err != nil {
+		return errors.Wrap(err, "failed to create session vars file")
+	}
+	defer file.Close()
+
+	if err = yaml.NewEncoder(file).Encode(sessionVars{Session: session, Values: values}); err != nil {
+		return errors.Wrap(err, "failed to encode session vars file")
+	}
+
+	return nil
+}
+
+func (s *Service) getStickyFlagsPath() string {
+	return filepath.Join(s.directory, stickyFlagsFile)
+}
@@ -0,0 +1,96 @@
+package state
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetApplicationDirectory(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		applicationName string
+		expected        string
+	}{
+		"simple name": {
+			applicationName: "project-helper",
+			expected:        filepath.Join("state", "project-helper", "project-helper"),
+		},
+		"name with spaces": {
+			applicationName: "Project Helper/Test",
+			expected:        filepath.Join("state", "project-helper", "Project-Helper-Test"),
+		},
+		"empty name": {
+			expected: filepath.Join("state", "project-helper", "default"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, GetApplicationDirectory("state", testCase.applicationName))
+		})
+	}
+}
+
+func TestStickyFlags(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(filepath.Join(t.TempDir(), "application"))
+
+	stickyFlags, err := service.GetStickyFlags()
+	require.NoError(t, err)
+	assert.Empty(t, stickyFlags)
+
+	err = service.SaveStickyFlags(map[string][]string{
+		"env":      {"prod"},
+		"services": {"api", "web"},
+	})
+	require.NoError(t, err)
+
+	stickyFlags, err = service.GetStickyFlags()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"env": {"prod"}, "services": {"api", "web"}}, stickyFlags)
+
+	err = service.ResetStickyFlags("env")
+	require.NoError(t, err)
+
+	stickyFlags, err = service.GetStickyFlags()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"services": {"api", "web"}}, stickyFlags)
+
+	err = service.ResetStickyFlags()
+	require.NoError(t, err)
+
+	stickyFlags, err = service.GetStickyFlags()
+	require.NoError(t, err)
+	assert.Empty(t, stickyFlags)
+
+	err = service.ResetStickyFlags()
+	require.NoError(t, err)
+}
+
+func TestSessionVars(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(filepath.Join(t.TempDir(), "application"))
+
+	values, err := service.GetSessionVars("100")
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	err = service.SaveSessionVars("100", map[string]string{"cluster": "prod-eu"})
+	require.NoError(t, err)
+
+	values, err = service.GetSessionVars("100")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cluster": "prod-eu"}, values)
+
+	values, err = service.GetSessionVars("200")
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
@@ -0,0 +1,41 @@
+package additional
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"path/filepath"
+
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+)
+
+type (
+	ConfigService interface {
+		GetAdditionalArgs() map[string]string
+		GetApplicationPath() string
+	}
+)
+
+type Service struct {
+	configService ConfigService
+}
+
+func NewService(configService ConfigService) *Service {
+	return &Service{
+		configService: configService,
+	}
+}
+
+func (s *Service) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
+	if name == entity.ExecutionPathTag && request.Operation.ChangePath {
+		if filepath.IsAbs(request.Operation.ExecutionPath) {
+			return request.Operation.ExecutionPath, true, nil
+		}
+
+		return filepath.Join(s.configService.GetApplicationPath(), request.Operation.ExecutionPath), true, nil
+	}
+
+	value, ok := s.configService.GetAdditionalArgs()[name]
+
+	return value, ok, nil
+}
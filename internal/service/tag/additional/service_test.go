@@ -0,0 +1,104 @@
+package additional
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/service/tag/additional/mocks"
+)
+
+func TestGetTagValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		preconditions func(t *testController)
+		operation     config.Operation
+		name          string
+		output        string
+		found         bool
+	}{
+		"success with additional arg": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetAdditionalArgs().Return(map[string]string{
+					entity.ApplicationPathTag: "application-path",
+				})
+			},
+			name:   entity.ApplicationPathTag,
+			output: "application-path",
+			found:  true,
+		},
+		"success with execution-path tag": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetApplicationPath().Return("application-path")
+			},
+			operation: config.Operation{
+				ExecutionPath: "execution-path",
+				ChangePath:    true,
+			},
+			name:   entity.ExecutionPathTag,
+			output: "application-path/execution-path",
+			found:  true,
+		},
+		"success with absolute execution-path tag": {
+			preconditions: func(*testController) {},
+			operation: config.Operation{
+				ExecutionPath: "/deploy",
+				ChangePath:    true,
+			},
+			name:   entity.ExecutionPathTag,
+			output: "/deploy",
+			found:  true,
+		},
+		"execution-path tag without change path": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetAdditionalArgs().Return(map[string]string{})
+			},
+			operation: config.Operation{
+				ExecutionPath: "execution-path",
+			},
+			name: entity.ExecutionPathTag,
+		},
+		"unknown tag": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetAdditionalArgs().Return(map[string]string{})
+			},
+			name: "unknown",
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			output, found, err := controller.Build().GetTagValue(&dto.GetTagValueRequest{Operation: testCase.operation}, testCase.name)
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.found, found)
+			assert.Equal(t, testCase.output, output)
+		})
+	}
+}
+
+type testController struct {
+	configService *mocks.MockConfigService
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService: mocks.NewMockConfigService(ctrl),
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(t.configService)
+}
@@ -0,0 +1,168 @@
+package builtin
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"os/user"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+)
+
+const dateLayout = "2006-01-02"
+
+type (
+	ConfigService interface {
+		GetApplicationPath() string
+	}
+)
+
+type resolver func() (string, error)
+
+type Service struct {
+	configService ConfigService
+	startedAt     time.Time
+	resolvers     map[string]resolver
+	values        map[string]string
+	mutex         sync.Mutex
+}
+
+func NewService(configService ConfigService) *Service {
+	s := &Service{
+		configService: configService,
+		startedAt:     time.Now(),
+		values:        make(map[string]string),
+	}
+
+	s.resolvers = map[string]resolver{
+		entity.GitBranchTag:   s.git("rev-parse", "--abbrev-ref", "HEAD"),
+		entity.GitShaTag:      s.git("rev-parse", "HEAD"),
+		entity.GitShortShaTag: s.git("rev-parse", "--short", "HEAD"),
+		entity.GitRootTag:     s.git("rev-parse", "--show-toplevel"),
+		entity.GitDirtyTag:    s.gitDirty,
+		entity.TimestampTag: func() (string, error) {
+			return strconv.FormatInt(s.startedAt.Unix(), 10), nil
+		},
+		entity.DateTag: func() (string, error) {
+			return s.startedAt.Format(dateLayout), nil
+		},
+		entity.UserTag:     getUser,
+		entity.HostnameTag: func() (string, error) { return os.Hostname() },
+		entity.OsTag:       func() (string, error) { return runtime.GOOS, nil },
+		entity.ArchTag:     func() (string, error) { return runtime.GOARCH, nil },
+		entity.CwdTag:      func() (string, error) { return os.Getwd() },
+		entity.RunIdTag:    generateRunId,
+	}
+
+	return s
+}
+
+func (s *Service) GetBuiltinTagValue(name string, operation config.Operation) (string, bool, error) {
+	if name == entity.OperationTag {
+		return operation.Name, true, nil
+	}
+
+	resolve, ok := s.resolvers[name]
+	if !ok {
+		return "", false, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if value, ok := s.values[name]; ok {
+		return value, true, nil
+	}
+
+	value, err := resolve()
+	if err != nil {
+		return "", true, errors.Wrapf(err, "failed to resolve built-in tag %s", name)
+	}
+
+	s.values[name] = value
+
+	return value, true, nil
+}
+
+func (s *Service) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
+	return s.GetBuiltinTagValue(name, request.Operation)
+}
+
+// GitProvider exposes the git built-in tags under the git namespace, so
+// ${{git.branch}} resolves the same value as ${{git-branch}}.
+type GitProvider struct {
+	service *Service
+}
+
+func NewGitProvider(service *Service) *GitProvider {
+	return &GitProvider{
+		service: service,
+	}
+}
+
+func (p *GitProvider) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
+	return p.service.GetBuiltinTagValue(entity.GitTagNamespace+"-"+name, request.Operation)
+}
+
+func (s *Service) git(args ...string) resolver {
+	return func() (string, error) {
+		return s.runGit(args...)
+	}
+}
+
+func (s *Service) gitDirty() (string, error) {
+	status, err := s.runGit("status", "--porcelain")
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatBool(status != ""), nil
+}
+
+func (s *Service) runGit(args ...string) (string, error) {
+	command := exec.Command("git", append([]string{"-C", s.configService.GetApplicationPath()}, args...)...)
+
+	output, err := command.Output()
+	if err != nil {
+		var exitError *exec.ExitError
+		if errors.As(err, &exitError) {
+			return "", errors.Wrapf(err, "git %s failed: %s", strings.Join(args, " "), strings.TrimSpace(string(exitError.Stderr)))
+		}
+
+		return "", errors.Wrapf(err, "failed to run git %s", strings.Join(args, " "))
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func getUser() (string, error) {
+	if current, err := user.Current(); err == nil {
+		return current.Username, nil
+	}
+
+	if name := os.Getenv("USER"); name != "" {
+		return name, nil
+	}
+
+	return "", errors.New("failed to get current user")
+}
+
+func generateRunId() (string, error) {
+	bytes := make([]byte, 8)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", errors.Wrap(err, "failed to generate run id")
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
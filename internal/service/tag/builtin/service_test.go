@@ -0,0 +1,174 @@
+package builtin
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/service/tag/builtin/mocks"
+)
+
+func TestGetBuiltinTagValueGit(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+
+	runGit(t, dir, "init", "--initial-branch=main")
+	runGit(t, dir, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "initial")
+	sha := runGit(t, dir, "rev-parse", "HEAD")
+	root := runGit(t, dir, "rev-parse", "--show-toplevel")
+
+	controller := newTestController(gomock.NewController(t))
+	controller.configService.EXPECT().GetApplicationPath().Return(dir).AnyTimes()
+
+	service := controller.Build()
+
+	tests := map[string]string{
+		entity.GitBranchTag:   "main",
+		entity.GitShaTag:      sha,
+		entity.GitShortShaTag: sha[:7],
+		entity.GitRootTag:     root,
+		entity.GitDirtyTag:    "false",
+	}
+
+	for name, expected := range tests {
+		value, ok, err := service.GetBuiltinTagValue(name, config.Operation{})
+
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, expected, value, name)
+	}
+
+	value, ok, err := NewGitProvider(service).GetTagValue(&dto.GetTagValueRequest{}, "branch")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "main", value)
+
+	_, ok, err = NewGitProvider(service).GetTagValue(&dto.GetTagValueRequest{}, "unknown")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file.txt"), []byte("content"), 0o644))
+
+	value, _, err = service.GetBuiltinTagValue(entity.GitDirtyTag, config.Operation{})
+	require.NoError(t, err)
+	assert.Equal(t, "false", value, "built-in tags are resolved once per run")
+}
+
+func TestGetBuiltinTagValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		preconditions func(*testController)
+		name          string
+		operation     config.Operation
+		expected      string
+		expectedOk    bool
+		expectedErr   error
+	}{
+		"operation": {
+			name:       entity.OperationTag,
+			operation:  config.Operation{Name: "build"},
+			expected:   "build",
+			expectedOk: true,
+		},
+		"os": {
+			name:       entity.OsTag,
+			expected:   runtime.GOOS,
+			expectedOk: true,
+		},
+		"arch": {
+			name:       entity.ArchTag,
+			expected:   runtime.GOARCH,
+			expectedOk: true,
+		},
+		"unknown tag": {
+			name: "unknown",
+		},
+		"git outside repository": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetApplicationPath().Return(filepath.Join(os.TempDir(), "project-helper-missing"))
+			},
+			name:        entity.GitShaTag,
+			expectedOk:  true,
+			expectedErr: errors.New("failed to resolve built-in tag git-sha"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			value, ok, err := controller.Build().GetBuiltinTagValue(testCase.name, testCase.operation)
+
+			assert.Equal(t, testCase.expectedOk, ok)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, value)
+			}
+		})
+	}
+}
+
+func TestGetBuiltinTagValueIsStablePerRun(t *testing.T) {
+	t.Parallel()
+
+	service := newTestController(gomock.NewController(t)).Build()
+
+	for _, name := range []string{entity.RunIdTag, entity.TimestampTag, entity.DateTag, entity.CwdTag, entity.HostnameTag, entity.UserTag} {
+		first, ok, err := service.GetBuiltinTagValue(name, config.Operation{})
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.NotEmpty(t, first, name)
+
+		second, _, err := service.GetBuiltinTagValue(name, config.Operation{})
+		require.NoError(t, err)
+		assert.Equal(t, first, second, name)
+	}
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	output, err := exec.Command("git", append([]string{"-C", dir}, args...)...).Output()
+	require.NoError(t, err)
+
+	return string(output[:len(output)-1])
+}
+
+type testController struct {
+	configService *mocks.MockConfigService
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService: mocks.NewMockConfigService(ctrl),
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(t.configService)
+}
@@ -0,0 +1,44 @@
+package env
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/domain/dto"
+	domainerrors "project-helper/internal/domain/errors"
+)
+
+type Service struct {
+	lookup func(name string) (string, bool)
+}
+
+func NewService() *Service {
+	return &Service{
+		lookup: os.LookupEnv,
+	}
+}
+
+func (s *Service) GetEnvValue(name string) (string, error) {
+	value, ok := s.lookup(name)
+	if !ok {
+		return "", errors.Wrapf(domainerrors.ErrorEnvVariableNotSet, "environment variable %s is not set", name)
+	}
+
+	return value, nil
+}
+
+// GetTagValue resolves the tags nested in the variable value, so an
+// environment variable can compose other tags.
+func (s *Service) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
+	value, err := s.GetEnvValue(name)
+	if err != nil {
+		return "", true, err
+	}
+
+	value, err = request.Resolve(value)
+	if err != nil {
+		return "", true, errors.Wrapf(err, "failed to resolve environment variable %s", name)
+	}
+
+	return value, true, nil
+}
@@ -0,0 +1,86 @@
+package env
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/domain/dto"
+)
+
+func TestGetEnvValue(t *testing.T) {
+	t.Parallel()
+
+	environment := map[string]string{
+		"HOME":  "/home/user",
+		"EMPTY": "",
+	}
+
+	tests := map[string]struct {
+		name        string
+		output      string
+		expectedErr error
+	}{
+		"success": {
+			name:   "HOME",
+			output: "/home/user",
+		},
+		"empty value": {
+			name: "EMPTY",
+		},
+		"unset variable": {
+			name:        "MISSING",
+			expectedErr: errors.New("environment variable MISSING is not set"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service := &Service{
+				lookup: func(key string) (string, bool) {
+					value, ok := environment[key]
+					return value, ok
+				},
+			}
+
+			output, err := service.GetEnvValue(testCase.name)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.output, output)
+			}
+		})
+	}
+}
+
+func TestGetTagValue(t *testing.T) {
+	t.Parallel()
+
+	service := &Service{
+		lookup: func(key string) (string, bool) {
+			return map[string]string{"DEPLOY_DIR": "${{application-path}}/deploy"}[key], key == "DEPLOY_DIR"
+		},
+	}
+
+	request := &dto.GetTagValueRequest{
+		ResolveTags: func(value string) (string, error) {
+			return strings.ReplaceAll(value, "${{application-path}}", "/src"), nil
+		},
+	}
+
+	value, found, err := service.GetTagValue(request, "DEPLOY_DIR")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "/src/deploy", value)
+
+	_, found, err = service.GetTagValue(request, "MISSING")
+	assert.True(t, found)
+	assert.ErrorContains(t, err, "environment variable MISSING is not set")
+}
@@ -2,6 +2,9 @@ package extractor
 
 import (
 	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -9,24 +12,29 @@ import (
 	domainerrors "project-helper/internal/domain/errors"
 )
 
+const filterSeparator = '|'
+
 type Service struct {
 	extractorRegexp *regexp.Regexp
 }
 
 func NewService() *Service {
 	return &Service{
-		extractorRegexp: regexp.MustCompile("\\$\\{\\{([a-zA-Z-0-9]+)\\}\\}"),
+		extractorRegexp: regexp.MustCompile(`\$\{\{([a-zA-Z0-9_-]+(?:[.+][a-zA-Z0-9_-]+)*|[a-zA-Z0-9_-]+:[^\s|}]+)(\.\.\.)?((?:\s*\|(?:[^}"]|"(?:[^"\\]|\\.)*")*)?)\}\}`),
 	}
 }
 
 func (s *Service) ExtractTags(arg entity.Arg) entity.Tags {
-	allString := s.extractorRegexp.FindAllString(string(arg), -1)
+	allIndexes := s.extractorRegexp.FindAllStringIndex(string(arg), -1)
 
-	tags := make(entity.Tags, len(allString))
+	tags := make(entity.Tags, 0, len(allIndexes))
 
-	for i, tag := range allString {
-		tags[i] = entity.Tag(tag)
+	for _, index := range allIndexes {
+		if strings.HasSuffix(string(arg[:index[0]]), entity.TagEscape) {
+			continue
+		}
 
+		tags = append(tags, entity.Tag(arg[index[0]:index[1]]))
 	}
 
 	return tags
@@ -44,3 +52,130 @@ func (s *Service) ExtractTag(tag entity.Tag) (string, error) {
 
 	return extractedTagValue, nil
 }
+
+func (s *Service) ParseTag(tag entity.Tag) (*entity.TagExpression, error) {
+	tagValues := s.extractorRegexp.FindStringSubmatch(string(tag))
+	if len(tagValues) == 0 {
+		return nil, errors.Wrapf(domainerrors.ErrorTagValueNotFound, "tag '%s' is not valid", tag)
+	}
+
+	filters, err := parseFilters(tagValues[3])
+	if err != nil {
+		return nil, errors.Wrapf(err, "tag '%s' has invalid filters", tag)
+	}
+
+	return &entity.TagExpression{
+		Name:    tagValues[1],
+		Splat:   tagValues[2] != "",
+		Filters: filters,
+	}, nil
+}
+
+func parseFilters(expression string) (entity.TagFilters, error) {
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return nil, nil
+	}
+
+	segments, err := splitOutsideQuotes(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	// the expression starts with a separator, so the first segment is always empty
+	filters := make(entity.TagFilters, 0, len(segments)-1)
+
+	for _, segment := range segments[1:] {
+		tokens, err := tokenize(segment)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(tokens) == 0 {
+			return nil, errors.New("empty filter")
+		}
+
+		filters = append(filters, entity.TagFilter{
+			Name: tokens[0],
+			Args: tokens[1:],
+		})
+	}
+
+	return filters, nil
+}
+
+func splitOutsideQuotes(expression string) ([]string, error) {
+	var (
+		segments []string
+		start    int
+		quoted   bool
+	)
+
+	for i := 0; i < len(expression); i++ {
+		switch expression[i] {
+		case '\\':
+			if quoted {
+				i++
+			}
+		case '"':
+			quoted = !quoted
+		case filterSeparator:
+			if !quoted {
+				segments = append(segments, expression[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if quoted {
+		return nil, errors.New("unterminated quoted filter argument")
+	}
+
+	return append(segments, expression[start:]), nil
+}
+
+func tokenize(segment string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(segment); {
+		if unicode.IsSpace(rune(segment[i])) {
+			i++
+
+			continue
+		}
+
+		if segment[i] != '"' {
+			end := strings.IndexFunc(segment[i:], unicode.IsSpace)
+			if end == -1 {
+				end = len(segment) - i
+			}
+
+			tokens = append(tokens, segment[i:i+end])
+			i += end
+
+			continue
+		}
+
+		end := i + 1
+		for end < len(segment) && segment[end] != '"' {
+			if segment[end] == '\\' {
+				end++
+			}
+			end++
+		}
+
+		if end >= len(segment) {
+			return nil, errors.New("unterminated quoted filter argument")
+		}
+
+		token, err := strconv.Unquote(segment[i : end+1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid quoted filter argument %s", segment[i:end+1])
+		}
+
+		tokens = append(tokens, token)
+		i = end + 1
+	}
+
+	return tokens, nil
+}
@@ -18,6 +18,10 @@ func TestExtractTags(t *testing.T) {
 
 	assert.Len(t, tags, 2)
 	assert.ElementsMatch(t, entity.Tags{"${{tag1}}", "${{tag2}}"}, tags)
+
+	tags = service.ExtractTags("$${{tag1}} ${{tag2}} $${{tag3}}")
+
+	assert.Equal(t, entity.Tags{"${{tag2}}"}, tags)
 }
 
 func TestExtractTag(t *testing.T) {
@@ -32,6 +36,22 @@ func TestExtractTag(t *testing.T) {
 			tag:      "${{tag1}}",
 			expected: "tag1",
 		},
+		"dotted tag": {
+			tag:      "${{label.team}}",
+			expected: "label.team",
+		},
+		"env tag": {
+			tag:      "${{env.GOPATH_DIR}}",
+			expected: "env.GOPATH_DIR",
+		},
+		"splat tag": {
+			tag:      "${{services...}}",
+			expected: "services",
+		},
+		"invalid dotted tag": {
+			tag:         "${{label.}}",
+			expectedErr: errors.New("tag '${{label.}}' is not valid: tag value not found"),
+		},
 		"invalid tag": {
 			tag:         "tag1",
 			expectedErr: errors.New("tag 'tag1' is not valid: tag value not found"),
@@ -63,3 +83,111 @@ func TestExtractTag(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "tag1", tag)
 }
+
+func TestParseTag(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tag         entity.Tag
+		expected    *entity.TagExpression
+		expectedErr error
+	}{
+		"without filters": {
+			tag:      "${{env}}",
+			expected: &entity.TagExpression{Name: "env"},
+		},
+		"with default": {
+			tag: `${{env | default "dev"}}`,
+			expected: &entity.TagExpression{
+				Name:    "env",
+				Filters: entity.TagFilters{{Name: "default", Args: []string{"dev"}}},
+			},
+		},
+		"env tag with default": {
+			tag: `${{env.HOME | default "/root"}}`,
+			expected: &entity.TagExpression{
+				Name:    "env.HOME",
+				Filters: entity.TagFilters{{Name: "default", Args: []string{"/root"}}},
+			},
+		},
+		"splat tag": {
+			tag:      "${{services...}}",
+			expected: &entity.TagExpression{Name: "services", Splat: true},
+		},
+		"splat tag with filters": {
+			tag: `${{services... | upper}}`,
+			expected: &entity.TagExpression{
+				Name:    "services",
+				Splat:   true,
+				Filters: entity.TagFilters{{Name: "upper", Args: []string{}}},
+			},
+		},
+		"with filter pipeline": {
+			tag: `${{branch | lower | replace "/" "-"}}`,
+			expected: &entity.TagExpression{
+				Name: "branch",
+				Filters: entity.TagFilters{
+					{Name: "lower", Args: []string{}},
+					{Name: "replace", Args: []string{"/", "-"}},
+				},
+			},
+		},
+		"with quoted separator and braces": {
+			tag: `${{services | join " | " | replace "}}" "\""}}`,
+			expected: &entity.TagExpression{
+				Name: "services",
+				Filters: entity.TagFilters{
+					{Name: "join", Args: []string{" | "}},
+					{Name: "replace", Args: []string{"}}", `"`}},
+				},
+			},
+		},
+		"composite tag": {
+			tag:      "${{env+region}}",
+			expected: &entity.TagExpression{Name: "env+region"},
+		},
+		"file tag with filter": {
+			tag: "${{file:build/VERSION | trim}}",
+			expected: &entity.TagExpression{
+				Name:    "file:build/VERSION",
+				Filters: entity.TagFilters{{Name: "trim", Args: []string{}}},
+			},
+		},
+		"structured file tag": {
+			tag:      "${{yaml:chart/values.yaml#.image.tag}}",
+			expected: &entity.TagExpression{Name: "yaml:chart/values.yaml#.image.tag"},
+		},
+		"with empty filter": {
+			tag:         "${{env | }}",
+			expectedErr: errors.New("tag '${{env | }}' has invalid filters: empty filter"),
+		},
+		"invalid tag": {
+			tag:         "env",
+			expectedErr: errors.New("tag 'env' is not valid: tag value not found"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := NewService().ParseTag(testCase.tag)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, expression)
+			}
+		})
+	}
+}
+
+func TestExtractTagsWithFilters(t *testing.T) {
+	t.Parallel()
+
+	tags := NewService().ExtractTags(`--name=${{name | quote}}-${{env | default "dev"}} ${{ github.sha }}`)
+
+	assert.Equal(t, entity.Tags{"${{name | quote}}", `${{env | default "dev"}}`}, tags)
+}
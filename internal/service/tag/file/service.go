@@ -0,0 +1,172 @@
+package file
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"project-helper/internal/domain/dto"
+)
+
+const fieldSeparator = "#"
+
+type Format string
+
+const (
+	Raw  Format = "raw"
+	JSON Format = "json"
+	YAML Format = "yaml"
+)
+
+type (
+	ConfigService interface {
+		GetApplicationPath() string
+	}
+)
+
+type Service struct {
+	configService ConfigService
+	format        Format
+}
+
+func NewService(configService ConfigService, format Format) *Service {
+	return &Service{
+		configService: configService,
+		format:        format,
+	}
+}
+
+func (s *Service) GetTagValue(_ *dto.GetTagValueRequest, name string) (string, bool, error) {
+	path, field := name, ""
+	if s.format != Raw {
+		path, field, _ = strings.Cut(name, fieldSeparator)
+	}
+
+	content, err := os.ReadFile(s.resolvePath(path))
+	if err != nil {
+		return "", true, errors.Wrapf(err, "failed to read file %s", path)
+	}
+
+	if s.format == Raw {
+		return string(content), true, nil
+	}
+
+	var document any
+
+	switch s.format {
+	case JSON:
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		if err = decoder.Decode(&document); err == nil && decoder.More() {
+			err = errors.New("unexpected data after the top-level value")
+		}
+	case YAML:
+		err = yaml.Unmarshal(content, &document)
+	default:
+		return "", true, errors.Errorf("unknown file format %s", s.format)
+	}
+
+	if err != nil {
+		return "", true, errors.Wrapf(err, "failed to parse %s file %s", s.format, path)
+	}
+
+	value, err := getField(normalize(document), field)
+	if err != nil {
+		return "", true, errors.Wrapf(err, "failed to get field '%s' of %s", field, path)
+	}
+
+	return value, true, nil
+}
+
+func (s *Service) resolvePath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(s.configService.GetApplicationPath(), path)
+}
+
+func getField(document any, field string) (string, error) {
+	value := document
+
+	for _, key := range strings.Split(strings.Trim(field, "."), ".") {
+		if key == "" {
+			continue
+		}
+
+		switch node := value.(type) {
+		case map[string]any:
+			child, ok := node[key]
+			if !ok {
+				return "", errors.Errorf("key %s not found", key)
+			}
+
+			value = child
+		case []any:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", errors.Errorf("index %s is out of range", key)
+			}
+
+			value = node[index]
+		default:
+			return "", errors.Errorf("key %s is not found in a scalar value", key)
+		}
+	}
+
+	return format(value)
+}
+
+func format(value any) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case map[string]any, []any:
+		content, err := json.Marshal(value)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to encode value")
+		}
+
+		return string(content), nil
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
+
+// normalize converts the map[interface{}]interface{} nodes produced by yaml
+// into map[string]any, so both formats share the same lookup and encoding.
+func normalize(value any) any {
+	switch value := value.(type) {
+	case map[any]any:
+		normalized := make(map[string]any, len(value))
+		for key, child := range value {
+			normalized[fmt.Sprint(key)] = normalize(child)
+		}
+
+		return normalized
+	case map[string]any:
+		for key, child := range value {
+			value[key] = normalize(child)
+		}
+
+		return value
+	case []any:
+		for i, child := range value {
+			value[i] = normalize(child)
+		}
+
+		return value
+	default:
+		return value
+	}
+}
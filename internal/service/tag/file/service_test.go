@@ -0,0 +1,137 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/service/tag/file/mocks"
+)
+
+func TestGetTagValue(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile(t, dir, "VERSION", "1.4.2\n")
+	writeFile(t, dir, "package.json", `{"name": "app", "port": 8080, "private": true, "files": ["dist", "lib"]}`)
+	writeFile(t, dir, "chart/values.yaml", "image:\n  repository: registry.example.com/app\n  tag: v1\nreplicas: 2\nports:\n  - 80\n  - 443\n")
+
+	tests := map[string]struct {
+		format      Format
+		name        string
+		output      string
+		expectedErr error
+	}{
+		"raw file": {
+			format: Raw,
+			name:   "VERSION",
+			output: "1.4.2\n",
+		},
+		"absolute path": {
+			format: Raw,
+			name:   filepath.Join(dir, "VERSION"),
+			output: "1.4.2\n",
+		},
+		"missing file": {
+			format:      Raw,
+			name:        "MISSING",
+			expectedErr: errors.New("failed to read file MISSING"),
+		},
+		"json string field": {
+			format: JSON,
+			name:   "package.json#.name",
+			output: "app",
+		},
+		"json number field": {
+			format: JSON,
+			name:   "package.json#.port",
+			output: "8080",
+		},
+		"json bool field": {
+			format: JSON,
+			name:   "package.json#.private",
+			output: "true",
+		},
+		"json array index": {
+			format: JSON,
+			name:   "package.json#.files.1",
+			output: "lib",
+		},
+		"json array field": {
+			format: JSON,
+			name:   "package.json#.files",
+			output: `["dist","lib"]`,
+		},
+		"json missing field": {
+			format:      JSON,
+			name:        "package.json#.version",
+			expectedErr: errors.New("failed to get field '.version' of package.json: key version not found"),
+		},
+		"invalid json": {
+			format:      JSON,
+			name:        "VERSION#.name",
+			expectedErr: errors.New("failed to parse json file VERSION"),
+		},
+		"yaml nested field": {
+			format: YAML,
+			name:   "chart/values.yaml#.image.repository",
+			output: "registry.example.com/app",
+		},
+		"yaml number field": {
+			format: YAML,
+			name:   "chart/values.yaml#.replicas",
+			output: "2",
+		},
+		"yaml map field": {
+			format: YAML,
+			name:   "chart/values.yaml#.image",
+			output: `{"repository":"registry.example.com/app","tag":"v1"}`,
+		},
+		"yaml out of range index": {
+			format:      YAML,
+			name:        "chart/values.yaml#.ports.2",
+			expectedErr: errors.New("index 2 is out of range"),
+		},
+		"yaml key of scalar": {
+			format:      YAML,
+			name:        "chart/values.yaml#.replicas.count",
+			expectedErr: errors.New("key count is not found in a scalar value"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			configService := mocks.NewMockConfigService(gomock.NewController(t))
+			configService.EXPECT().GetApplicationPath().Return(dir).AnyTimes()
+
+			output, ok, err := NewService(configService, testCase.format).GetTagValue(&dto.GetTagValueRequest{}, testCase.name)
+
+			assert.True(t, ok)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.output, output)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
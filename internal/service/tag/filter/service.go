@@ -0,0 +1,109 @@
+package filter
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/domain/entity"
+)
+
+type definition struct {
+	args  int
+	apply func(value string, args []string) string
+}
+
+type Service struct {
+	definitions map[string]definition
+}
+
+func NewService() *Service {
+	return &Service{
+		definitions: map[string]definition{
+			entity.DefaultTagFilter: {args: 1, apply: func(value string, args []string) string {
+				if value == "" {
+					return args[0]
+				}
+
+				return value
+			}},
+			"lower": {apply: func(value string, _ []string) string { return strings.ToLower(value) }},
+			"upper": {apply: func(value string, _ []string) string { return strings.ToUpper(value) }},
+			"trim":  {apply: func(value string, _ []string) string { return strings.TrimSpace(value) }},
+			"replace": {args: 2, apply: func(value string, args []string) string {
+				return strings.ReplaceAll(value, args[0], args[1])
+			}},
+			"base":  {apply: func(value string, _ []string) string { return filepath.Base(value) }},
+			"dir":   {apply: func(value string, _ []string) string { return filepath.Dir(value) }},
+			"quote": {apply: func(value string, _ []string) string { return quote(value) }},
+
+			// a single value has nothing to join, tag values are joined by ApplyValues
+			entity.JoinTagFilter: {args: 1, apply: func(value string, _ []string) string { return value }},
+		},
+	}
+}
+
+func (s *Service) Validate(filters entity.TagFilters) error {
+	for _, filter := range filters {
+		filterDefinition, ok := s.definitions[filter.Name]
+		if !ok {
+			return errors.Errorf("unknown filter %s", filter.Name)
+		}
+
+		if len(filter.Args) != filterDefinition.args {
+			return errors.Errorf("filter %s expects %d argument(s), got %d", filter.Name, filterDefinition.args, len(filter.Args))
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) Apply(value string, filters entity.TagFilters) (string, error) {
+	if err := s.Validate(filters); err != nil {
+		return "", errors.Wrap(err, "filters are not valid")
+	}
+
+	for _, filter := range filters {
+		value = s.definitions[filter.Name].apply(value, filter.Args)
+	}
+
+	return value, nil
+}
+
+// ApplyValues applies the filters before the first join to every value, joins the values with its separator, or
+// with the default one without a join, and applies the remaining filters to the result.
+func (s *Service) ApplyValues(values []string, filters entity.TagFilters) (string, error) {
+	if err := s.Validate(filters); err != nil {
+		return "", errors.Wrap(err, "filters are not valid")
+	}
+
+	separator, index := entity.DefaultSeparator, len(filters)
+
+	if joinIndex := slices.IndexFunc(filters, func(filter entity.TagFilter) bool {
+		return filter.Name == entity.JoinTagFilter
+	}); joinIndex != -1 {
+		separator, index = filters[joinIndex].Args[0], joinIndex
+	}
+
+	filteredValues := make([]string, 0, len(values))
+
+	for _, value := range values {
+		filteredValue, err := s.Apply(value, filters[:index])
+		if err != nil {
+			return "", err
+		}
+
+		filteredValues = append(filteredValues, filteredValue)
+	}
+
+	if index == len(filters) {
+		return strings.Join(filteredValues, separator), nil
+	}
+
+	return s.Apply(strings.Join(filteredValues, separator), filters[index+1:])
+}
+
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
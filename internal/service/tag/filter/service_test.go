@@ -0,0 +1,156 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/domain/entity"
+)
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       string
+		filters     entity.TagFilters
+		expected    string
+		expectedErr error
+	}{
+		"without filters": {
+			value:    "value",
+			expected: "value",
+		},
+		"default for empty value": {
+			filters:  entity.TagFilters{{Name: "default", Args: []string{"dev"}}},
+			expected: "dev",
+		},
+		"default for provided value": {
+			value:    "prod",
+			filters:  entity.TagFilters{{Name: "default", Args: []string{"dev"}}},
+			expected: "prod",
+		},
+		"lower and replace": {
+			value: "Feature/ABC-1",
+			filters: entity.TagFilters{
+				{Name: "lower"},
+				{Name: "replace", Args: []string{"/", "-"}},
+			},
+			expected: "feature-abc-1",
+		},
+		"upper and trim": {
+			value:    "  value ",
+			filters:  entity.TagFilters{{Name: "trim"}, {Name: "upper"}},
+			expected: "VALUE",
+		},
+		"join of a single value": {
+			value:    "api,web",
+			filters:  entity.TagFilters{{Name: "join", Args: []string{" "}}},
+			expected: "api,web",
+		},
+		"base and dir": {
+			value:    "/path/to/file.txt",
+			filters:  entity.TagFilters{{Name: "dir"}, {Name: "base"}},
+			expected: "to",
+		},
+		"quote": {
+			value:    "it's",
+			filters:  entity.TagFilters{{Name: "quote"}},
+			expected: `'it'\''s'`,
+		},
+		"quote single character": {
+			value:    "x",
+			filters:  entity.TagFilters{{Name: "quote"}},
+			expected: `'x'`,
+		},
+		"quote backslash": {
+			value:    `a\nb`,
+			filters:  entity.TagFilters{{Name: "quote"}},
+			expected: `'a\nb'`,
+		},
+		"unknown filter": {
+			value:       "value",
+			filters:     entity.TagFilters{{Name: "unknown"}},
+			expectedErr: errors.New("filters are not valid: unknown filter unknown"),
+		},
+		"wrong arguments": {
+			value:       "value",
+			filters:     entity.TagFilters{{Name: "replace", Args: []string{"a"}}},
+			expectedErr: errors.New("filters are not valid: filter replace expects 2 argument(s), got 1"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := NewService().Apply(testCase.value, testCase.filters)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, value)
+			}
+		})
+	}
+}
+
+func TestApplyValues(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values      []string
+		filters     entity.TagFilters
+		expected    string
+		expectedErr error
+	}{
+		"without join": {
+			values:   []string{"api", "web"},
+			filters:  entity.TagFilters{{Name: "upper"}},
+			expected: "API,WEB",
+		},
+		"join": {
+			values:   []string{"api", "web"},
+			filters:  entity.TagFilters{{Name: "join", Args: []string{" "}}},
+			expected: "api web",
+		},
+		"values with commas": {
+			values:   []string{"a,b", "c"},
+			filters:  entity.TagFilters{{Name: "join", Args: []string{";"}}},
+			expected: "a,b;c",
+		},
+		"filters before and after join": {
+			values: []string{"api", "web"},
+			filters: entity.TagFilters{
+				{Name: "replace", Args: []string{"a", "A"}},
+				{Name: "join", Args: []string{" "}},
+				{Name: "quote"},
+			},
+			expected: "'Api web'",
+		},
+		"unknown filter": {
+			values:      []string{"api"},
+			filters:     entity.TagFilters{{Name: "unknown"}},
+			expectedErr: errors.New("unknown filter unknown"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := NewService().ApplyValues(testCase.values, testCase.filters)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, value)
+			}
+		})
+	}
+}
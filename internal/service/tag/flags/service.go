@@ -0,0 +1,71 @@
+package flags
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+)
+
+type Service struct{}
+
+func NewService() *Service {
+	return &Service{}
+}
+
+func (s *Service) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
+	if flagName, key, ok := strings.Cut(name, "."); ok {
+		if _, err := request.Flags.GetFlag(flagName); err != nil {
+			return "", false, nil
+		}
+
+		value, err := request.Flags.GetRequiredFlagMapKeyValue(flagName, key)
+		if err != nil {
+			return "", true, errors.Wrap(err, "failed to get flag key value")
+		}
+
+		return value, true, nil
+	}
+
+	flag, err := request.Flags.GetFlag(name)
+	if err != nil {
+		if name == entity.PassThroughArgsTag {
+			return strings.Join(request.Flags.PassThroughArgs, " "), true, nil
+		}
+
+		return "", false, nil
+	}
+
+	value, err := entity.GetString(flag)
+	if err != nil {
+		return "", true, errors.Wrap(err, "failed to get flag value")
+	}
+
+	return value, true, nil
+}
+
+// GetTagValues returns every value of an array flag, or the pass-through args.
+func (s *Service) GetTagValues(request *dto.GetTagValueRequest, name string) ([]string, bool, error) {
+	flag, err := request.Flags.GetFlag(name)
+	if err != nil {
+		if name == entity.PassThroughArgsTag {
+			return slices.Clone(request.Flags.PassThroughArgs), true, nil
+		}
+
+		value, ok, err := s.GetTagValue(request, name)
+		if err != nil || !ok {
+			return nil, ok, err
+		}
+
+		return []string{value}, true, nil
+	}
+
+	values, err := entity.GetValues(flag)
+	if err != nil {
+		return nil, true, errors.Wrap(err, "failed to get flag values")
+	}
+
+	return values, true, nil
+}
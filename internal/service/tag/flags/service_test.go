@@ -0,0 +1,191 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/utils"
+)
+
+func TestGetTagValue(t *testing.T) {
+	t.Parallel()
+
+	flags := &entity.Flags{
+		DynamicFlags: map[string]*entity.DynamicFlagValue{
+			"tag1": {
+				Name:  "tag1",
+				Type:  entity.String,
+				Value: utils.MakePointer("tag1—value"),
+			},
+			"services": {
+				Name:  "services",
+				Type:  entity.Array,
+				Value: &[]string{"api", "web"},
+			},
+			"label": {
+				Name:      "label",
+				Type:      entity.Map,
+				Value:     &map[string]string{"team": "core", "tier": "2"},
+				Separator: " ",
+			},
+			"env": {
+				Name:  "env",
+				Type:  entity.String,
+				Value: utils.MakePointer("dev"),
+			},
+		},
+		PassThroughArgs: []string{"-run", "TestFoo"},
+	}
+
+	tests := map[string]struct {
+		flags       *entity.Flags
+		name        string
+		output      string
+		found       bool
+		expectedErr error
+	}{
+		"success with string tag": {
+			name:   "tag1",
+			output: "tag1—value",
+			found:  true,
+		},
+		"success with array tag": {
+			name:   "services",
+			output: "api,web",
+			found:  true,
+		},
+		"success with map tag": {
+			name:   "label",
+			output: "team=core tier=2",
+			found:  true,
+		},
+		"success with map key tag": {
+			name:   "label.team",
+			output: "core",
+			found:  true,
+		},
+		"with missing map key tag": {
+			name:        "label.owner",
+			found:       true,
+			expectedErr: errors.New("failed to get flag key value: flag label has no key owner"),
+		},
+		"success with map flag named like a namespace": {
+			flags: &entity.Flags{
+				DynamicFlags: map[string]*entity.DynamicFlagValue{
+					"env": {
+						Name:  "env",
+						Type:  entity.Map,
+						Value: &map[string]string{"HOME": "/custom"},
+					},
+				},
+			},
+			name:   "env.HOME",
+			output: "/custom",
+			found:  true,
+		},
+		"success with pass through args tag": {
+			name:   entity.PassThroughArgsTag,
+			output: "-run TestFoo",
+			found:  true,
+		},
+		"unknown tag": {
+			name: "unknown",
+		},
+		"unknown dotted tag": {
+			name: "unknown.key",
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &dto.GetTagValueRequest{Flags: flags}
+			if testCase.flags != nil {
+				request.Flags = testCase.flags
+			}
+
+			output, found, err := NewService().GetTagValue(request, testCase.name)
+
+			assert.Equal(t, testCase.found, found)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.output, output)
+			}
+		})
+	}
+}
+
+func TestGetTagValues(t *testing.T) {
+	t.Parallel()
+
+	flags := &entity.Flags{
+		DynamicFlags: map[string]*entity.DynamicFlagValue{
+			"env":      {Name: "env", Type: entity.String, Value: utils.MakePointer("dev")},
+			"services": {Name: "services", Type: entity.Array, Value: &[]string{"api", "web"}},
+			"empty":    {Name: "empty", Type: entity.Array, Value: &[]string{}},
+			"label":    {Name: "label", Type: entity.Map, Value: &map[string]string{"tier": "2", "team": "core"}},
+		},
+		PassThroughArgs: []string{"-run", "TestFoo"},
+	}
+
+	tests := map[string]struct {
+		name   string
+		output []string
+		found  bool
+	}{
+		"success with array tag": {
+			name:   "services",
+			output: []string{"api", "web"},
+			found:  true,
+		},
+		"success with empty array tag": {
+			name:   "empty",
+			output: []string{},
+			found:  true,
+		},
+		"success with map tag": {
+			name:   "label",
+			output: []string{"team=core", "tier=2"},
+			found:  true,
+		},
+		"success with map key tag": {
+			name:   "label.team",
+			output: []string{"core"},
+			found:  true,
+		},
+		"success with string tag": {
+			name:   "env",
+			output: []string{"dev"},
+			found:  true,
+		},
+		"success with pass through args tag": {
+			name:   entity.PassThroughArgsTag,
+			output: []string{"-run", "TestFoo"},
+			found:  true,
+		},
+		"unknown tag": {
+			name: "unknown",
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, found, err := NewService().GetTagValues(&dto.GetTagValueRequest{Flags: flags}, testCase.name)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.found, found)
+			assert.Equal(t, testCase.output, output)
+		})
+	}
+}
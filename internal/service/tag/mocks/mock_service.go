@@ -9,58 +9,102 @@
 package mocks
 
 import (
+	dto "project-helper/internal/domain/dto"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
 )
 
-// MockConfigService is a mock of ConfigService interface.
-type MockConfigService struct {
+// MockTagProvider is a mock of TagProvider interface.
+type MockTagProvider struct {
 	ctrl     *gomock.Controller
-	recorder *MockConfigServiceMockRecorder
+	recorder *MockTagProviderMockRecorder
 }
 
-// MockConfigServiceMockRecorder is the mock recorder for MockConfigService.
-type MockConfigServiceMockRecorder struct {
-	mock *MockConfigService
+// MockTagProviderMockRecorder is the mock recorder for MockTagProvider.
+type MockTagProviderMockRecorder struct {
+	mock *MockTagProvider
 }
 
-// NewMockConfigService creates a new mock instance.
-func NewMockConfigService(ctrl *gomock.Controller) *MockConfigService {
-	mock := &MockConfigService{ctrl: ctrl}
-	mock.recorder = &MockConfigServiceMockRecorder{mock}
+// NewMockTagProvider creates a new mock instance.
+func NewMockTagProvider(ctrl *gomock.Controller) *MockTagProvider {
+	mock := &MockTagProvider{ctrl: ctrl}
+	mock.recorder = &MockTagProviderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockConfigService) EXPECT() *MockConfigServiceMockRecorder {
+func (m *MockTagProvider) EXPECT() *MockTagProviderMockRecorder {
 	return m.recorder
 }
 
-// GetAdditionalArgs mocks base method.
-func (m *MockConfigService) GetAdditionalArgs() map[string]string {
+// GetTagValue mocks base method.
+func (m *MockTagProvider) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAdditionalArgs")
-	ret0, _ := ret[0].(map[string]string)
-	return ret0
+	ret := m.ctrl.Call(m, "GetTagValue", request, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetAdditionalArgs indicates an expected call of GetAdditionalArgs.
-func (mr *MockConfigServiceMockRecorder) GetAdditionalArgs() *gomock.Call {
+// GetTagValue indicates an expected call of GetTagValue.
+func (mr *MockTagProviderMockRecorder) GetTagValue(request, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdditionalArgs", reflect.TypeOf((*MockConfigService)(nil).GetAdditionalArgs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagValue", reflect.TypeOf((*MockTagProvider)(nil).GetTagValue), request, name)
+}
+
+// MockTagValuesProvider is a mock of TagValuesProvider interface.
+type MockTagValuesProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagValuesProviderMockRecorder
+}
+
+// MockTagValuesProviderMockRecorder is the mock recorder for MockTagValuesProvider.
+type MockTagValuesProviderMockRecorder struct {
+	mock *MockTagValuesProvider
 }
 
-// GetApplicationPath mocks base method.
-func (m *MockConfigService) GetApplicationPath() string {
+// NewMockTagValuesProvider creates a new mock instance.
+func NewMockTagValuesProvider(ctrl *gomock.Controller) *MockTagValuesProvider {
+	mock := &MockTagValuesProvider{ctrl: ctrl}
+	mock.recorder = &MockTagValuesProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagValuesProvider) EXPECT() *MockTagValuesProviderMockRecorder {
+	return m.recorder
+}
+
+// GetTagValue mocks base method.
+func (m *MockTagValuesProvider) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetApplicationPath")
+	ret := m.ctrl.Call(m, "GetTagValue", request, name)
 	ret0, _ := ret[0].(string)
-	return ret0
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTagValue indicates an expected call of GetTagValue.
+func (mr *MockTagValuesProviderMockRecorder) GetTagValue(request, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagValue", reflect.TypeOf((*MockTagValuesProvider)(nil).GetTagValue), request, name)
+}
+
+// GetTagValues mocks base method.
+func (m *MockTagValuesProvider) GetTagValues(request *dto.GetTagValueRequest, name string) ([]string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagValues", request, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetApplicationPath indicates an expected call of GetApplicationPath.
-func (mr *MockConfigServiceMockRecorder) GetApplicationPath() *gomock.Call {
+// GetTagValues indicates an expected call of GetTagValues.
+func (mr *MockTagValuesProviderMockRecorder) GetTagValues(request, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationPath", reflect.TypeOf((*MockConfigService)(nil).GetApplicationPath))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagValues", reflect.TypeOf((*MockTagValuesProvider)(nil).GetTagValues), request, name)
 }
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	entity "project-helper/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExtractorService is a mock of ExtractorService interface.
+type MockExtractorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtractorServiceMockRecorder
+}
+
+// MockExtractorServiceMockRecorder is the mock recorder for MockExtractorService.
+type MockExtractorServiceMockRecorder struct {
+	mock *MockExtractorService
+}
+
+// NewMockExtractorService creates a new mock instance.
+func NewMockExtractorService(ctrl *gomock.Controller) *MockExtractorService {
+	mock := &MockExtractorService{ctrl: ctrl}
+	mock.recorder = &MockExtractorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtractorService) EXPECT() *MockExtractorServiceMockRecorder {
+	return m.recorder
+}
+
+// ExtractTags mocks base method.
+func (m *MockExtractorService) ExtractTags(arg entity.Arg) entity.Tags {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTags", arg)
+	ret0, _ := ret[0].(entity.Tags)
+	return ret0
+}
+
+// ExtractTags indicates an expected call of ExtractTags.
+func (mr *MockExtractorServiceMockRecorder) ExtractTags(arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTags", reflect.TypeOf((*MockExtractorService)(nil).ExtractTags), arg)
+}
+
+// ParseTag mocks base method.
+func (m *MockExtractorService) ParseTag(tag entity.Tag) (*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTag", tag)
+	ret0, _ := ret[0].(*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTag indicates an expected call of ParseTag.
+func (mr *MockExtractorServiceMockRecorder) ParseTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTag", reflect.TypeOf((*MockExtractorService)(nil).ParseTag), tag)
+}
@@ -0,0 +1,127 @@
+package reference
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/entity"
+)
+
+type (
+	ExtractorService interface {
+		ExtractTags(arg entity.Arg) entity.Tags
+		ParseTag(tag entity.Tag) (*entity.TagExpression, error)
+	}
+)
+
+type Service struct {
+	extractorService ExtractorService
+}
+
+func NewService(extractorService ExtractorService) *Service {
+	return &Service{
+		extractorService: extractorService,
+	}
+}
+
+// GetOperationTags returns the tags an operation reaches: the tags of its args
+// and execution path, and those of the vars and predefinedArgs tables it uses,
+// transitively. The flags of predefinedArgsTag bindings are not tags.
+func (s *Service) GetOperationTags(application *config.Application, operation config.Operation) ([]*entity.TagExpression, error) {
+	w := &walk{
+		extractorService: s.extractorService,
+		vars:             application.GetVars(operation),
+		tables:           application.GetPredefinedArgs(),
+		visitedVars:      make(map[string]bool),
+		visitedTables:    make(map[string]bool),
+	}
+
+	for _, predefinedArgsTag := range operation.GetPredefinedArgsTags() {
+		if err := w.addTable(predefinedArgsTag.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	var values []string
+	if !operation.RawArgs {
+		values = append(values, operation.Args...)
+	}
+
+	if err := w.addValues(append(values, operation.ExecutionPath)); err != nil {
+		return nil, errors.Wrapf(err, "operation %s is not valid", operation.Name)
+	}
+
+	return w.tags, nil
+}
+
+type walk struct {
+	extractorService ExtractorService
+	vars             map[string]config.Var
+	tables           map[string]config.PredefinedArg
+	visitedVars      map[string]bool
+	visitedTables    map[string]bool
+	tags             []*entity.TagExpression
+}
+
+func (w *walk) addValues(values []string) error {
+	for _, value := range values {
+		for _, tag := range w.extractorService.ExtractTags(entity.Arg(value)) {
+			tagExpression, err := w.extractorService.ParseTag(tag)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse tag %s", tag)
+			}
+
+			w.tags = append(w.tags, tagExpression)
+
+			// a plain name is read from a var first and then looked up in the table of the same name
+			varName, namespaced := strings.CutPrefix(tagExpression.Name, entity.VarTagNamespace+".")
+			if err = w.addVar(varName); err != nil {
+				return err
+			}
+
+			if namespaced {
+				continue
+			}
+
+			if err = w.addTable(tagExpression.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *walk) addVar(name string) error {
+	variable, ok := w.vars[name]
+	if !ok || w.visitedVars[name] {
+		return nil
+	}
+
+	w.visitedVars[name] = true
+
+	return errors.Wrapf(w.addValues([]string{variable.GetExpression()}), "var %s is not valid", name)
+}
+
+func (w *walk) addTable(name string) error {
+	predefinedArg, ok := w.tables[name]
+	if !ok || w.visitedTables[name] {
+		return nil
+	}
+
+	w.visitedTables[name] = true
+
+	var values []string
+	for _, arg := range predefinedArg.Args {
+		values = append(values, arg.Values...)
+	}
+
+	if predefinedArg.Source != nil {
+		values = append(values, predefinedArg.Source.Values...)
+	}
+
+	return errors.Wrapf(w.addValues(values), "predefined arg %s is not valid", name)
+}
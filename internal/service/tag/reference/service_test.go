@@ -0,0 +1,90 @@
+package reference
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/config"
+	"project-helper/internal/service/tag/extractor"
+)
+
+func TestGetOperationTags(t *testing.T) {
+	t.Parallel()
+
+	application := &config.Application{
+		Vars: config.Vars{
+			{Name: "image", Value: "${{registry}}/app:${{flag.tag}}"},
+			{Name: "registry", Cmd: "echo ${{region}}"},
+			{Name: "loop", Value: "${{var.loop}}"},
+		},
+		PredefinedArgs: config.PredefinedArgs{
+			{Name: "target", Args: config.Args{{Name: "prod", Values: []string{"${{cluster}}.txt"}}}},
+			{Name: "cluster", Source: &config.ArgsSource{Dir: "clusters", Values: []string{"${{env.HOME}}/${{match.0}}"}}},
+			{Name: "unused", Args: config.Args{{Name: "key", Values: []string{"${{secret}}"}}}},
+		},
+	}
+
+	tests := map[string]struct {
+		operation   config.Operation
+		expected    []string
+		expectedErr error
+	}{
+		"args and execution path": {
+			operation: config.Operation{Args: []string{"${{env}}", "${{env... | join \",\"}}"}, ExecutionPath: "${{dir}}"},
+			expected:  []string{"env", "env", "dir"},
+		},
+		"vars": {
+			operation: config.Operation{Args: []string{"${{image}}"}},
+			expected:  []string{"image", "registry", "region", "flag.tag"},
+		},
+		"operation vars": {
+			operation: config.Operation{Args: []string{"${{var.image}}"}, Vars: config.Vars{{Name: "image", Value: "${{name}}"}}},
+			expected:  []string{"var.image", "name"},
+		},
+		"var cycle": {
+			operation: config.Operation{Args: []string{"${{loop}}"}},
+			expected:  []string{"loop", "var.loop"},
+		},
+		"predefinedArgs tables": {
+			operation: config.Operation{Args: []string{"${{target}}"}},
+			expected:  []string{"target", "cluster", "env.HOME", "match.0"},
+		},
+		"predefinedArgsTag binding": {
+			operation: config.Operation{PredefinedArgsTag: &config.PredefinedArgsTag{Name: "env", Value: "target"}},
+			expected:  []string{"cluster", "env.HOME", "match.0"},
+		},
+		"raw args": {
+			operation: config.Operation{Args: []string{"${{image}}"}, RawArgs: true},
+		},
+		"invalid tag": {
+			operation:   config.Operation{Name: "deploy", Args: []string{"${{image | }}"}},
+			expectedErr: errors.New("operation deploy is not valid: failed to parse tag"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tags, err := NewService(extractor.NewService()).GetOperationTags(application, testCase.operation)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(tags))
+			for _, tag := range tags {
+				names = append(names, tag.Name)
+			}
+
+			assert.ElementsMatch(t, testCase.expected, names)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package resource
+
+import (
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+)
+
+const (
+	nameSeparator   = ":"
+	tmpDirPattern   = "project-helper-"
+	maxPortAttempts = 10
+)
+
+type Service struct {
+	ports   map[string]string
+	used    map[string]bool
+	tmpDirs map[string]string
+	mutex   sync.Mutex
+}
+
+func NewService() *Service {
+	return &Service{
+		ports:   make(map[string]string),
+		used:    make(map[string]bool),
+		tmpDirs: make(map[string]string),
+	}
+}
+
+func (s *Service) GetTagValue(_ *dto.GetTagValueRequest, name string) (string, bool, error) {
+	tag, key, _ := strings.Cut(name, nameSeparator)
+
+	switch tag {
+	case entity.FreePortTag:
+		value, err := s.getFreePort(key)
+
+		return value, true, err
+	case entity.TmpDirTag:
+		value, err := s.getTmpDir(key)
+
+		return value, true, err
+	default:
+		return "", false, nil
+	}
+}
+
+// Cleanup removes the temporary directories created during the run.
+func (s *Service) Cleanup() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, dir := range s.tmpDirs {
+		if err := os.RemoveAll(dir); err != nil {
+			return errors.Wrapf(err, "failed to remove temporary directory %s", dir)
+		}
+
+		delete(s.tmpDirs, key)
+	}
+
+	return nil
+}
+
+func (s *Service) getFreePort(key string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if port, ok := s.ports[key]; ok {
+		return port, nil
+	}
+
+	for range maxPortAttempts {
+		port, err := findFreePort()
+		if err != nil {
+			return "", err
+		}
+
+		if s.used[port] {
+			continue
+		}
+
+		s.used[port] = true
+		s.ports[key] = port
+
+		return port, nil
+	}
+
+	return "", errors.Errorf("failed to find a free port for %s after %d attempts", key, maxPortAttempts)
+}
+
+func (s *Service) getTmpDir(key string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if dir, ok := s.tmpDirs[key]; ok {
+		return dir, nil
+	}
+
+	pattern := tmpDirPattern
+	if key != "" {
+		pattern += key + "-"
+	}
+
+	dir, err := os.MkdirTemp("", pattern)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create temporary directory")
+	}
+
+	s.tmpDirs[key] = dir
+
+	return dir, nil
+}
+
+func findFreePort() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find a free port")
+	}
+	defer listener.Close()
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read free port")
+	}
+
+	return port, nil
+}
@@ -0,0 +1,72 @@
+package resource
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/domain/dto"
+)
+
+func TestGetTagValueFreePort(t *testing.T) {
+	t.Parallel()
+
+	service := NewService()
+	request := &dto.GetTagValueRequest{}
+
+	port, ok, err := service.GetTagValue(request, "free-port")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	number, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	assert.Positive(t, number)
+
+	again, _, err := service.GetTagValue(request, "free-port")
+	require.NoError(t, err)
+	assert.Equal(t, port, again, "free ports are stable within a run")
+
+	db, _, err := service.GetTagValue(request, "free-port:db")
+	require.NoError(t, err)
+	assert.NotEqual(t, port, db, "named free ports are distinct")
+
+	dbAgain, _, err := service.GetTagValue(request, "free-port:db")
+	require.NoError(t, err)
+	assert.Equal(t, db, dbAgain)
+}
+
+func TestGetTagValueTmpDir(t *testing.T) {
+	t.Parallel()
+
+	service := NewService()
+	request := &dto.GetTagValueRequest{}
+
+	dir, ok, err := service.GetTagValue(request, "tmpdir")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.DirExists(t, dir)
+
+	again, _, err := service.GetTagValue(request, "tmpdir")
+	require.NoError(t, err)
+	assert.Equal(t, dir, again)
+
+	cache, _, err := service.GetTagValue(request, "tmpdir:cache")
+	require.NoError(t, err)
+	assert.NotEqual(t, dir, cache)
+	assert.Contains(t, cache, "project-helper-cache-")
+
+	require.NoError(t, service.Cleanup())
+
+	assert.NoDirExists(t, dir)
+	assert.NoDirExists(t, cache)
+}
+
+func TestGetTagValueUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, ok, err := NewService().GetTagValue(&dto.GetTagValueRequest{}, "tag1")
+
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,38 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/domain/dto"
+	domainerrors "project-helper/internal/domain/errors"
+)
+
+type Service struct {
+	directory string
+}
+
+func NewService(directory string) *Service {
+	return &Service{
+		directory: directory,
+	}
+}
+
+func (s *Service) GetTagValue(_ *dto.GetTagValueRequest, name string) (string, bool, error) {
+	if name == "" || name == "." || name == ".." || name != filepath.Base(name) {
+		return "", true, errors.Errorf("secret name '%s' is not valid", name)
+	}
+
+	content, err := os.ReadFile(filepath.Join(s.directory, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", true, errors.Wrapf(domainerrors.ErrorSecretNotFound, "secret %s not found in %s", name, s.directory)
+		}
+
+		return "", true, errors.Wrapf(err, "failed to read secret %s", name)
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), true, nil
+}
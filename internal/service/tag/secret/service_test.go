@@ -0,0 +1,56 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project-helper/internal/domain/dto"
+)
+
+func TestGetTagValue(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "token"), []byte("s3cr3t\n"), 0o600))
+
+	tests := map[string]struct {
+		name        string
+		output      string
+		expectedErr error
+	}{
+		"success": {
+			name:   "token",
+			output: "s3cr3t",
+		},
+		"missing secret": {
+			name:        "missing",
+			expectedErr: errors.New("secret missing not found"),
+		},
+		"invalid name": {
+			name:        "..",
+			expectedErr: errors.New("secret name '..' is not valid"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, ok, err := NewService(directory).GetTagValue(&dto.GetTagValueRequest{}, testCase.name)
+
+			assert.True(t, ok)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.output, output)
+			}
+		})
+	}
+}
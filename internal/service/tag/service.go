@@ -3,33 +3,52 @@ package tag
 //go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
 
 import (
-	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
-	"project-helper/internal/config"
 	"project-helper/internal/domain/dto"
-	"project-helper/internal/domain/entity"
 	domainerrors "project-helper/internal/domain/errors"
 	"project-helper/internal/utils"
 )
 
 type (
-	ConfigService interface {
-		GetAdditionalArgs() map[string]string
-		GetApplicationPath() string
+	// TagProvider resolves tag names from a single source. The bool result
+	// reports whether the provider knows the name, so the next one can be asked.
+	TagProvider interface {
+		GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error)
+	}
+	// TagValuesProvider is a TagProvider whose tags can hold several values,
+	// which splat tags expand into separate args.
+	TagValuesProvider interface {
+		TagProvider
+		GetTagValues(request *dto.GetTagValueRequest, name string) ([]string, bool, error)
 	}
 )
 
+type provider struct {
+	namespace string
+	provider  TagProvider
+}
+
 type Service struct {
-	configService ConfigService
+	providers []provider
 }
 
-func NewService(
-	configService ConfigService,
-) *Service {
-	return &Service{
-		configService: configService,
-	}
+func NewService() *Service {
+	return &Service{}
+}
+
+// Register appends a provider to the lookup chain. A provider registered with
+// a namespace only receives tags prefixed by "<namespace>." or "<namespace>:"
+// with the prefix stripped. Other tags are asked from the providers without a
+// namespace in registration order, so a namespaced tag can't be shadowed by a
+// flag or var of the same name.
+func (s *Service) Register(namespace string, tagProvider TagProvider) {
+	s.providers = append(s.providers, provider{
+		namespace: namespace,
+		provider:  tagProvider,
+	})
 }
 
 func (s *Service) GetTagValue(request *dto.GetTagValueRequest) (string, error) {
@@ -37,32 +56,122 @@ func (s *Service) GetTagValue(request *dto.GetTagValueRequest) (string, error) {
 		return "", errors.Wrap(err, "request is not valid")
 	}
 
-	if flag, err := request.Flags.GetFlag(request.ExtractedTag); err != nil {
-		if additionalArg, err := s.checkAdditionalArgs(request.Operation, request.ExtractedTag); err != nil {
-			return "", errors.Wrap(err, "failed to check additional args")
-		} else {
-			return additionalArg, nil
+	for _, candidate := range s.getCandidates(request.ExtractedTag) {
+		value, ok, err := candidate.provider.GetTagValue(request, candidate.name)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get tag %s value", request.ExtractedTag)
 		}
-	} else {
-		flagStringValue, err := entity.GetString(flag)
+
+		if ok {
+			return value, nil
+		}
+	}
+
+	return "", errors.Wrapf(domainerrors.ErrorTagValueNotFound, "tag %s not found", request.ExtractedTag)
+}
+
+func (s *Service) GetTagValues(request *dto.GetTagValueRequest) ([]string, error) {
+	if err := utils.Validate.Struct(request); err != nil {
+		return nil, errors.Wrap(err, "request is not valid")
+	}
+
+	for _, candidate := range s.getCandidates(request.ExtractedTag) {
+		values, ok, err := candidate.getValues(request)
 		if err != nil {
-			return "", errors.Wrap(err, "failed to get flag value")
+			return nil, errors.Wrapf(err, "failed to get tag %s values", request.ExtractedTag)
+		}
+
+		if ok {
+			return values, nil
+		}
+	}
+
+	return nil, errors.Wrapf(domainerrors.ErrorTagValueNotFound, "tag %s not found", request.ExtractedTag)
+}
+
+type candidate struct {
+	provider TagProvider
+	name     string
+}
+
+// getCandidates returns the providers of a namespaced tag, or else every
+// provider without a namespace.
+func (s *Service) getCandidates(tag string) []candidate {
+	var namespaced, plain []candidate
+
+	for _, registered := range s.providers {
+		if registered.namespace == "" {
+			plain = append(plain, candidate{provider: registered.provider, name: tag})
+
+			continue
 		}
 
-		return flagStringValue, nil
+		if name, ok := registered.match(tag); ok {
+			namespaced = append(namespaced, candidate{provider: registered.provider, name: name})
+		}
 	}
+
+	if len(namespaced) != 0 {
+		return namespaced
+	}
+
+	return plain
 }
 
-func (s *Service) checkAdditionalArgs(operation config.Operation, tag string) (string, error) {
-	additionalArgs := s.configService.GetAdditionalArgs()
+func (c candidate) getValues(request *dto.GetTagValueRequest) ([]string, bool, error) {
+	if valuesProvider, ok := c.provider.(TagValuesProvider); ok {
+		return valuesProvider.GetTagValues(request, c.name)
+	}
 
-	if operation.ChangePath {
-		additionalArgs[entity.ExecutionPathTag] = filepath.Join(s.configService.GetApplicationPath(), operation.ExecutionPath)
+	value, ok, err := c.provider.GetTagValue(request, c.name)
+	if err != nil || !ok {
+		return nil, ok, err
 	}
 
-	if value, ok := additionalArgs[tag]; !ok {
-		return "", errors.Wrapf(domainerrors.ErrorAdditionalArgNotFound, "additional arg %s not found", tag)
-	} else {
-		return value, nil
+	return []string{value}, true, nil
+}
+
+func (p provider) match(tag string) (string, bool) {
+	if p.namespace == "" {
+		return tag, true
+	}
+
+	if name, ok := strings.CutPrefix(tag, p.namespace+"."); ok {
+		return name, true
 	}
+
+	return strings.CutPrefix(tag, p.namespace+":")
+}
+
+type memoized struct {
+	provider TagProvider
+	values   map[string]string
+	mutex    sync.Mutex
+}
+
+// Memoize caches the values of a provider whose results don't depend on the
+// request, so each name is resolved at most once per run.
+func Memoize(tagProvider TagProvider) TagProvider {
+	return &memoized{
+		provider: tagProvider,
+		values:   make(map[string]string),
+	}
+}
+
+func (m *memoized) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if value, ok := m.values[name]; ok {
+		return value, true, nil
+	}
+
+	value, ok, err := m.provider.GetTagValue(request, name)
+	if err != nil || !ok {
+		return value, ok, err
+	}
+
+	m.values[name] = value
+
+	return value, true, nil
 }
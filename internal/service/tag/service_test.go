@@ -11,17 +11,12 @@ import (
 	"project-helper/internal/domain/dto"
 	"project-helper/internal/domain/entity"
 	"project-helper/internal/service/tag/mocks"
-	"project-helper/internal/utils"
 )
 
 func TestGetTagValue(t *testing.T) {
 	t.Parallel()
 
-	var (
-		operation = config.Operation{
-			Name: "operation",
-		}
-	)
+	operation := config.Operation{Name: "operation"}
 
 	tests := map[string]struct {
 		preconditions func(t *testController)
@@ -29,83 +24,90 @@ func TestGetTagValue(t *testing.T) {
 		output        string
 		expectedErr   error
 	}{
-		"success with string tag": {
+		"success with first provider": {
+			preconditions: func(t *testController) {
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("tag1—value", true, nil)
+			},
 			input: &dto.GetTagValueRequest{
-				Flags: &entity.Flags{
-					DynamicFlags: map[string]*entity.DynamicFlagValue{
-						"tag1": {
-							Name:  "tag1",
-							Type:  entity.String,
-							Value: utils.MakePointer("tag1—value"),
-						},
-					},
-				},
+				Flags:        &entity.Flags{},
 				Operation:    operation,
 				ExtractedTag: "tag1",
 			},
 			output: "tag1—value",
 		},
-		"success with string array tag": {
+		"success with fallback provider": {
+			preconditions: func(t *testController) {
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("", false, nil)
+				t.valuesProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("", false, nil)
+				t.fallbackProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("tag1—value", true, nil)
+			},
 			input: &dto.GetTagValueRequest{
-				Flags: &entity.Flags{
-					DynamicFlags: map[string]*entity.DynamicFlagValue{
-						"tag1": {
-							Name:  "tag1",
-							Type:  entity.Array,
-							Value: &[]string{"tag1—value", "tag2—value"},
-						},
-					},
-				},
+				Flags:        &entity.Flags{},
 				Operation:    operation,
 				ExtractedTag: "tag1",
 			},
-			output: "tag1—value,tag2—value",
+			output: "tag1—value",
 		},
-		"success without pattern tag matches": {
+		"success with namespaced provider": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetAdditionalArgs().Return(map[string]string{
-					"tag1": "tag1—value",
-				})
+				t.namespacedProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("tag1—value", true, nil)
 			},
 			input: &dto.GetTagValueRequest{
 				Flags:        &entity.Flags{},
 				Operation:    operation,
-				ExtractedTag: "tag1",
+				ExtractedTag: "test.tag1",
+			},
+			output: "tag1—value",
+		},
+		"success with colon namespaced provider": {
+			preconditions: func(t *testController) {
+				t.namespacedProvider.EXPECT().GetTagValue(gomock.Any(), "dir/tag1").Return("tag1—value", true, nil)
+			},
+			input: &dto.GetTagValueRequest{
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "test:dir/tag1",
 			},
 			output: "tag1—value",
 		},
-		"success without pattern tag matches and execution-path tag": {
+		"namespace is not shadowed by earlier provider": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetAdditionalArgs().Return(map[string]string{
-					"tag1": "tag1—value",
-				})
-				t.configService.EXPECT().GetApplicationPath().Return("application-path")
+				t.namespacedProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("", false, nil)
 			},
 			input: &dto.GetTagValueRequest{
-				Flags: &entity.Flags{},
-				Operation: config.Operation{
-					ExecutionPath: "execution-path",
-					ChangePath:    true,
-				},
-				ExtractedTag: entity.ExecutionPathTag,
-			},
-			output: "application-path/execution-path",
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "test.tag1",
+			},
+			expectedErr: errors.New("tag test.tag1 not found: tag value not found"),
+		},
+		"with provider error": {
+			preconditions: func(t *testController) {
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("", true, assert.AnError)
+			},
+			input: &dto.GetTagValueRequest{
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "tag1",
+			},
+			expectedErr: errors.New("failed to get tag tag1 value: assert.AnError general error for testing"),
 		},
-		"without pattern tag matches and no additional tag": {
+		"with unknown tag": {
 			preconditions: func(t *testController) {
-				t.configService.EXPECT().GetAdditionalArgs().Return(map[string]string{})
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("", false, nil)
+				t.valuesProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("", false, nil)
+				t.fallbackProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("", false, nil)
 			},
 			input: &dto.GetTagValueRequest{
 				Flags:        &entity.Flags{},
 				Operation:    operation,
 				ExtractedTag: "tag1",
 			},
-			expectedErr: errors.New("failed to check additional args: additional arg tag1 not found: additional arg not found"),
+			expectedErr: errors.New("tag tag1 not found: tag value not found"),
 		},
 		"with invalid request": {
-			preconditions: func(t *testController) {},
-			input:         &dto.GetTagValueRequest{},
-			expectedErr:   errors.New("request is not valid"),
+			input:       &dto.GetTagValueRequest{},
+			expectedErr: errors.New("request is not valid"),
 		},
 	}
 
@@ -113,40 +115,163 @@ func TestGetTagValue(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			ctrl := gomock.NewController(t)
-
-			controller := newTestController(ctrl)
+			controller := newTestController(gomock.NewController(t))
 
 			if testCase.preconditions != nil {
 				testCase.preconditions(controller)
 			}
 
-			service := controller.Build()
-
-			output, err := service.GetTagValue(testCase.input)
+			output, err := controller.Build().GetTagValue(testCase.input)
 
 			if testCase.expectedErr != nil {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, testCase.expectedErr.Error())
 			} else {
-				assert.NoError(t, err)
+				require.NoError(t, err)
 				assert.Equal(t, testCase.output, output)
 			}
 		})
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
 
+	tagProvider := mocks.NewMockTagProvider(gomock.NewController(t))
+	tagProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("tag1—value", true, nil).Times(1)
+	tagProvider.EXPECT().GetTagValue(gomock.Any(), "unknown").Return("", false, nil).Times(2)
+
+	memoized := Memoize(tagProvider)
+
+	for range 2 {
+		value, ok, err := memoized.GetTagValue(&dto.GetTagValueRequest{}, "tag1")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "tag1—value", value)
+
+		_, ok, err = memoized.GetTagValue(&dto.GetTagValueRequest{}, "unknown")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	}
+}
+
+func TestGetTagValues(t *testing.T) {
+	t.Parallel()
+
+	operation := config.Operation{Name: "operation"}
+
+	tests := map[string]struct {
+		preconditions func(t *testController)
+		input         *dto.GetTagValueRequest
+		output        []string
+		expectedErr   error
+	}{
+		"success with values provider": {
+			preconditions: func(t *testController) {
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "services").Return("", false, nil)
+				t.valuesProvider.EXPECT().GetTagValues(gomock.Any(), "services").Return([]string{"api", "web"}, true, nil)
+			},
+			input: &dto.GetTagValueRequest{
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "services",
+			},
+			output: []string{"api", "web"},
+		},
+		"success with provider tag": {
+			preconditions: func(t *testController) {
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("tag1—value", true, nil)
+			},
+			input: &dto.GetTagValueRequest{
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "tag1",
+			},
+			output: []string{"tag1—value"},
+		},
+		"success with namespaced provider": {
+			preconditions: func(t *testController) {
+				t.namespacedProvider.EXPECT().GetTagValue(gomock.Any(), "tag1").Return("tag1—value", true, nil)
+			},
+			input: &dto.GetTagValueRequest{
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "test.tag1",
+			},
+			output: []string{"tag1—value"},
+		},
+		"with values provider error": {
+			preconditions: func(t *testController) {
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "services").Return("", false, nil)
+				t.valuesProvider.EXPECT().GetTagValues(gomock.Any(), "services").Return(nil, true, assert.AnError)
+			},
+			input: &dto.GetTagValueRequest{
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "services",
+			},
+			expectedErr: errors.New("failed to get tag services values: assert.AnError general error for testing"),
+		},
+		"with unknown tag": {
+			preconditions: func(t *testController) {
+				t.provider.EXPECT().GetTagValue(gomock.Any(), "unknown").Return("", false, nil)
+				t.valuesProvider.EXPECT().GetTagValues(gomock.Any(), "unknown").Return(nil, false, nil)
+				t.fallbackProvider.EXPECT().GetTagValue(gomock.Any(), "unknown").Return("", false, nil)
+			},
+			input: &dto.GetTagValueRequest{
+				Flags:        &entity.Flags{},
+				Operation:    operation,
+				ExtractedTag: "unknown",
+			},
+			expectedErr: errors.New("tag unknown not found"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			output, err := controller.Build().GetTagValues(testCase.input)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.output, output)
+			}
+		})
 	}
 }
 
 type testController struct {
-	configService *mocks.MockConfigService
+	provider           *mocks.MockTagProvider
+	namespacedProvider *mocks.MockTagProvider
+	valuesProvider     *mocks.MockTagValuesProvider
+	fallbackProvider   *mocks.MockTagProvider
 }
 
 func newTestController(ctrl *gomock.Controller) *testController {
 	return &testController{
-		configService: mocks.NewMockConfigService(ctrl),
+		provider:           mocks.NewMockTagProvider(ctrl),
+		namespacedProvider: mocks.NewMockTagProvider(ctrl),
+		valuesProvider:     mocks.NewMockTagValuesProvider(ctrl),
+		fallbackProvider:   mocks.NewMockTagProvider(ctrl),
 	}
 }
 
 func (t *testController) Build() *Service {
-	return NewService(t.configService)
+	service := NewService()
+	service.Register("", t.provider)
+	service.Register("test", t.namespacedProvider)
+	service.Register("", t.valuesProvider)
+	service.Register("", t.fallbackProvider)
+
+	return service
 }
@@ -0,0 +1,200 @@
+package terminal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var errNoOptions = errors.New("no options provided")
+
+type Service struct {
+	reader      *bufio.Reader
+	writer      io.Writer
+	interactive bool
+}
+
+func NewService(reader io.Reader, writer io.Writer, interactive bool) *Service {
+	return &Service{
+		reader:      bufio.NewReader(reader),
+		writer:      writer,
+		interactive: interactive,
+	}
+}
+
+func (s *Service) IsInteractive() bool {
+	return s.interactive
+}
+
+func (s *Service) Input(message string) (string, error) {
+	for {
+		if _, err := fmt.Fprintf(s.writer, "%s: ", message); err != nil {
+			return "", errors.Wrap(err, "failed to write message")
+		}
+
+		line, err := s.readLine()
+		if err != nil {
+			return "", err
+		}
+
+		if line != "" {
+			return line, nil
+		}
+	}
+}
+
+func (s *Service) Select(message string, options []string) (string, error) {
+	if len(options) == 0 {
+		return "", errNoOptions
+	}
+
+	for {
+		if err := s.printOptions(message, options, "choose one"); err != nil {
+			return "", err
+		}
+
+		line, err := s.readLine()
+		if err != nil {
+			return "", err
+		}
+
+		if option, ok := findOption(options, line); ok {
+			return option, nil
+		}
+
+		_, _ = fmt.Fprintf(s.writer, "invalid choice '%s'\n", line)
+	}
+}
+
+func (s *Service) MultiSelect(message string, options []string) ([]string, error) {
+	if len(options) == 0 {
+		return nil, errNoOptions
+	}
+
+	for {
+		if err := s.printOptions(message, options, "choose one or more, comma separated"); err != nil {
+			return nil, err
+		}
+
+		line, err := s.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		selected, ok := findOptions(options, line)
+		if ok {
+			return selected, nil
+		}
+
+		_, _ = fmt.Fprintf(s.writer, "invalid choice '%s'\n", line)
+	}
+}
+
+func (s *Service) printOptions(message string, options []string, hint string) error {
+	var builder strings.Builder
+
+	builder.WriteString(message)
+	builder.WriteString(":\n")
+
+	for i, option := range options {
+		builder.WriteString(fmt.Sprintf("  %d) %s\n", i+1, option))
+	}
+
+	builder.WriteString(fmt.Sprintf("%s: ", hint))
+
+	if _, err := io.WriteString(s.writer, builder.String()); err != nil {
+		return errors.Wrap(err, "failed to write options")
+	}
+
+	return nil
+}
+
+func (s *Service) readLine() (string, error) {
+	line, err := s.reader.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", errors.Wrap(err, "failed to read input")
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+func findOption(options []string, value string) (string, bool) {
+	if index, err := strconv.Atoi(value); err == nil {
+		if index < 1 || index > len(options) {
+			return "", false
+		}
+
+		return options[index-1], true
+	}
+
+	for _, option := range options {
+		if option == value {
+			return option, true
+		}
+	}
+
+	return "", false
+}
+
+func findOptions(options []string, value string) ([]string, bool) {
+	var selected []string
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		option, ok := findOption(options, part)
+		if !ok {
+			return nil, false
+		}
+
+		selected = append(selected, option)
+	}
+
+	return selected, len(selected) != 0
+}
+
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+type readResult struct {
+	n   int
+	err error
+}
+
+// NewContextReader stops waiting for input once ctx is done, so an
+// interrupted prompt returns instead of blocking the run.
+func NewContextReader(ctx context.Context, reader io.Reader) io.Reader {
+	return &contextReader{ctx: ctx, reader: reader}
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	// the read can't be cancelled, so it is left behind when ctx is done first
+	buffer := make([]byte, len(p))
+	results := make(chan readResult, 1)
+
+	go func() {
+		n, err := r.reader.Read(buffer)
+		results <- readResult{n: n, err: err}
+	}()
+
+	select {
+	case result := <-results:
+		return copy(p, buffer[:result.n]), result.err
+	case <-r.ctx.Done():
+		return 0, r.ctx.Err()
+	}
+}
@@ -0,0 +1,175 @@
+package terminal
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input         string
+		expected      string
+		expectedError error
+	}{
+		"value": {
+			input:    "value\n",
+			expected: "value",
+		},
+		"empty line asks again": {
+			input:    "\n  value  \n",
+			expected: "value",
+		},
+		"value without new line": {
+			input:    "value",
+			expected: "value",
+		},
+		"end of input": {
+			input:         "",
+			expectedError: errors.New("failed to read input"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service := NewService(strings.NewReader(testCase.input), &bytes.Buffer{}, true)
+
+			value, err := service.Input("message")
+
+			if testCase.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, value)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input         string
+		options       []string
+		expected      string
+		expectedError error
+	}{
+		"by index": {
+			input:    "2\n",
+			options:  []string{"dev", "prod"},
+			expected: "prod",
+		},
+		"by value": {
+			input:    "dev\n",
+			options:  []string{"dev", "prod"},
+			expected: "dev",
+		},
+		"invalid choice asks again": {
+			input:    "3\nstage\n1\n",
+			options:  []string{"dev", "prod"},
+			expected: "dev",
+		},
+		"no options": {
+			expectedError: errors.New("no options provided"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output := &bytes.Buffer{}
+			service := NewService(strings.NewReader(testCase.input), output, true)
+
+			value, err := service.Select("env", testCase.options)
+
+			if testCase.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, value)
+				assert.Contains(t, output.String(), "env:\n  1) dev\n  2) prod\n")
+			}
+		})
+	}
+}
+
+func TestMultiSelect(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input         string
+		options       []string
+		expected      []string
+		expectedError error
+	}{
+		"by index and value": {
+			input:    "1, web\n",
+			options:  []string{"api", "web", "worker"},
+			expected: []string{"api", "web"},
+		},
+		"invalid choice asks again": {
+			input:    "api,db\n\n3\n",
+			options:  []string{"api", "web", "worker"},
+			expected: []string{"worker"},
+		},
+		"no options": {
+			expectedError: errors.New("no options provided"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service := NewService(strings.NewReader(testCase.input), &bytes.Buffer{}, true)
+
+			values, err := service.MultiSelect("services", testCase.options)
+
+			if testCase.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, values)
+			}
+		})
+	}
+}
+
+func TestContextReader(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	reader, writer := io.Pipe()
+	t.Cleanup(func() { _ = writer.Close() })
+
+	service := NewService(NewContextReader(ctx, reader), &bytes.Buffer{}, true)
+
+	go func() {
+		_, _ = writer.Write([]byte("value\n"))
+	}()
+
+	value, err := service.Input("name")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value)
+
+	cancel()
+
+	_, err = service.Input("name")
+	require.ErrorIs(t, err, context.Canceled)
+}
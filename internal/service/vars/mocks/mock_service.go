@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+//
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	config "project-helper/internal/config"
+	entity "project-helper/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigService is a mock of ConfigService interface.
+type MockConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigServiceMockRecorder
+}
+
+// MockConfigServiceMockRecorder is the mock recorder for MockConfigService.
+type MockConfigServiceMockRecorder struct {
+	mock *MockConfigService
+}
+
+// NewMockConfigService creates a new mock instance.
+func NewMockConfigService(ctrl *gomock.Controller) *MockConfigService {
+	mock := &MockConfigService{ctrl: ctrl}
+	mock.recorder = &MockConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigService) EXPECT() *MockConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationPath mocks base method.
+func (m *MockConfigService) GetApplicationPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetApplicationPath indicates an expected call of GetApplicationPath.
+func (mr *MockConfigServiceMockRecorder) GetApplicationPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationPath", reflect.TypeOf((*MockConfigService)(nil).GetApplicationPath))
+}
+
+// GetConfig mocks base method.
+func (m *MockConfigService) GetConfig() *config.Application {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*config.Application)
+	return ret0
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockConfigServiceMockRecorder) GetConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockConfigService)(nil).GetConfig))
+}
+
+// MockStateService is a mock of StateService interface.
+type MockStateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateServiceMockRecorder
+}
+
+// MockStateServiceMockRecorder is the mock recorder for MockStateService.
+type MockStateServiceMockRecorder struct {
+	mock *MockStateService
+}
+
+// NewMockStateService creates a new mock instance.
+func NewMockStateService(ctrl *gomock.Controller) *MockStateService {
+	mock := &MockStateService{ctrl: ctrl}
+	mock.recorder = &MockStateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateService) EXPECT() *MockStateServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSessionVars mocks base method.
+func (m *MockStateService) GetSessionVars(session string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionVars", session)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionVars indicates an expected call of GetSessionVars.
+func (mr *MockStateServiceMockRecorder) GetSessionVars(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionVars", reflect.TypeOf((*MockStateService)(nil).GetSessionVars), session)
+}
+
+// SaveSessionVars mocks base method.
+func (m *MockStateService) SaveSessionVars(session string, values map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSessionVars", session, values)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSessionVars indicates an expected call of SaveSessionVars.
+func (mr *MockStateServiceMockRecorder) SaveSessionVars(session, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSessionVars", reflect.TypeOf((*MockStateService)(nil).SaveSessionVars), session, values)
+}
+
+// MockExtractorService is a mock of ExtractorService interface.
+type MockExtractorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtractorServiceMockRecorder
+}
+
+// MockExtractorServiceMockRecorder is the mock recorder for MockExtractorService.
+type MockExtractorServiceMockRecorder struct {
+	mock *MockExtractorService
+}
+
+// NewMockExtractorService creates a new mock instance.
+func NewMockExtractorService(ctrl *gomock.Controller) *MockExtractorService {
+	mock := &MockExtractorService{ctrl: ctrl}
+	mock.recorder = &MockExtractorServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtractorService) EXPECT() *MockExtractorServiceMockRecorder {
+	return m.recorder
+}
+
+// ExtractTags mocks base method.
+func (m *MockExtractorService) ExtractTags(arg entity.Arg) entity.Tags {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTags", arg)
+	ret0, _ := ret[0].(entity.Tags)
+	return ret0
+}
+
+// ExtractTags indicates an expected call of ExtractTags.
+func (mr *MockExtractorServiceMockRecorder) ExtractTags(arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTags", reflect.TypeOf((*MockExtractorService)(nil).ExtractTags), arg)
+}
+
+// ParseTag mocks base method.
+func (m *MockExtractorService) ParseTag(tag entity.Tag) (*entity.TagExpression, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseTag", tag)
+	ret0, _ := ret[0].(*entity.TagExpression)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseTag indicates an expected call of ParseTag.
+func (mr *MockExtractorServiceMockRecorder) ParseTag(tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseTag", reflect.TypeOf((*MockExtractorService)(nil).ParseTag), tag)
+}
@@ -0,0 +1,283 @@
+package vars
+
+//go:generate mockgen -destination=mocks/mock_service.go -package=mocks -source=service.go
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+)
+
+type (
+	ConfigService interface {
+		GetConfig() *config.Application
+		GetApplicationPath() string
+	}
+	StateService interface {
+		GetSessionVars(session string) (map[string]string, error)
+		SaveSessionVars(session string, values map[string]string) error
+	}
+	ExtractorService interface {
+		ExtractTags(arg entity.Arg) entity.Tags
+		ParseTag(tag entity.Tag) (*entity.TagExpression, error)
+	}
+)
+
+type Service struct {
+	configService    ConfigService
+	stateService     StateService
+	extractorService ExtractorService
+	session          string
+	values           map[string]string
+	mutex            sync.Mutex
+}
+
+func NewService(
+	configService ConfigService,
+	stateService StateService,
+	extractorService ExtractorService,
+) *Service {
+	return &Service{
+		configService:    configService,
+		stateService:     stateService,
+		extractorService: extractorService,
+		session:          strconv.Itoa(os.Getppid()),
+		values:           make(map[string]string),
+	}
+}
+
+func (s *Service) GetVar(operation config.Operation, name string) (config.Var, bool) {
+	variable, ok := s.configService.GetConfig().GetVars(operation)[trimNamespace(name)]
+
+	return variable, ok
+}
+
+// GetTagValue resolves a var referenced as a tag. The tags of its value or
+// cmd are resolved by the request before the cmd runs.
+func (s *Service) GetTagValue(request *dto.GetTagValueRequest, name string) (string, bool, error) {
+	variable, ok := s.GetVar(request.Operation, name)
+	if !ok {
+		return "", false, nil
+	}
+
+	value, err := request.Resolve(variable.GetExpression())
+	if err != nil {
+		return "", true, errors.Wrapf(err, "failed to resolve var %s", name)
+	}
+
+	if variable.Cmd == "" {
+		return value, true, nil
+	}
+
+	value, err = s.GetCmdValue(variable, entity.RestoreEscapedTags(value))
+	if err != nil {
+		return "", true, errors.Wrapf(err, "failed to get var %s value", name)
+	}
+
+	return value, true, nil
+}
+
+func (s *Service) GetCmdValue(variable config.Var, cmd string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if value, ok := s.values[cmd]; ok {
+		return value, nil
+	}
+
+	var sessionVars map[string]string
+
+	if variable.Cache == entity.SessionCache {
+		storedVars, err := s.stateService.GetSessionVars(s.session)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get session vars")
+		}
+
+		if value, ok := storedVars[cmd]; ok {
+			s.values[cmd] = value
+
+			return value, nil
+		}
+
+		sessionVars = storedVars
+	}
+
+	value, err := s.runCmd(cmd)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to run command of var %s", variable.Name)
+	}
+
+	s.values[cmd] = value
+
+	if variable.Cache == entity.SessionCache {
+		sessionVars[cmd] = value
+
+		if err = s.stateService.SaveSessionVars(s.session, sessionVars); err != nil {
+			return "", errors.Wrap(err, "failed to save session vars")
+		}
+	}
+
+	return value, nil
+}
+
+// ValidateVars checks every var and returns all problems as config.Errors.
+func (s *Service) ValidateVars() error {
+	applicationConfig := s.configService.GetConfig()
+
+	dynamicFlags := make(map[string]bool, len(applicationConfig.DynamicFlags))
+	for _, dynamicFlag := range applicationConfig.DynamicFlags {
+		dynamicFlags[dynamicFlag.Name] = true
+	}
+
+	var errs config.Errors
+
+	for _, variable := range applicationConfig.Vars {
+		validateVar(fmt.Sprintf("vars[%s]", variable.Name), variable, dynamicFlags, &errs)
+	}
+
+	for _, operation := range applicationConfig.Operations {
+		for _, variable := range operation.Vars {
+			validateVar(fmt.Sprintf("operations[%s].vars[%s]", operation.Name, variable.Name), variable, dynamicFlags, &errs)
+		}
+	}
+
+	// Operations see the global vars too, so a cycle is reported once.
+	reported := make(map[string]bool)
+
+	addCycles := func(prefix string, vars map[string]config.Var, operationVars config.Vars) {
+		for _, cycle := range s.findCycles(vars) {
+			message := strings.Join(cycle, " -> ")
+			if reported[message] {
+				continue
+			}
+
+			reported[message] = true
+
+			path := fmt.Sprintf("vars[%s]", cycle[0])
+			if slices.ContainsFunc(operationVars, func(variable config.Var) bool { return variable.Name == cycle[0] }) {
+				path = fmt.Sprintf("%s.vars[%s]", prefix, cycle[0])
+			}
+
+			errs.Add(path, errors.Errorf("vars form a cycle: %s", message))
+		}
+	}
+
+	addCycles("", applicationConfig.GetVars(config.Operation{}), nil)
+
+	for _, operation := range applicationConfig.Operations {
+		addCycles(fmt.Sprintf("operations[%s]", operation.Name), applicationConfig.GetVars(operation), operation.Vars)
+	}
+
+	return errs.Err()
+}
+
+// findCycles returns every cycle of vars in name order, each starting at the
+// var it was found from.
+func (s *Service) findCycles(vars map[string]config.Var) [][]string {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	var (
+		cycles [][]string
+		states = make(map[string]int, len(vars))
+	)
+
+	var visit func(name string, path []string)
+	visit = func(name string, path []string) {
+		switch states[name] {
+		case visiting:
+			start := slices.Index(path, name)
+			cycles = append(cycles, append(slices.Clone(path[start:]), name))
+
+			return
+		case visited:
+			return
+		}
+
+		states[name] = visiting
+
+		for _, tag := range s.extractorService.ExtractTags(entity.Arg(vars[name].GetExpression())) {
+			// Invalid tags are reported by the validation of args.
+			tagExpression, err := s.extractorService.ParseTag(tag)
+			if err != nil {
+				continue
+			}
+
+			dependency := trimNamespace(tagExpression.Name)
+			if _, ok := vars[dependency]; !ok {
+				continue
+			}
+
+			visit(dependency, append(path, name))
+		}
+
+		states[name] = visited
+	}
+
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		visit(name, nil)
+	}
+
+	return cycles
+}
+
+func (s *Service) runCmd(cmd string) (string, error) {
+	command := exec.Command("sh", "-c", cmd)
+	command.Dir = s.configService.GetApplicationPath()
+	command.Stderr = os.Stderr
+
+	output, err := command.Output()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to run command")
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func validateVar(path string, variable config.Var, dynamicFlags map[string]bool, errs *config.Errors) {
+	add := func(path string, err error) {
+		errs.Add(path, errors.Wrapf(err, "var %s is not valid", variable.Name))
+	}
+
+	if variable.Name == "" {
+		add(path, errors.New("name is required"))
+	}
+
+	if variable.Value != "" && variable.Cmd != "" {
+		add(path, errors.New("value and cmd are mutually exclusive"))
+	}
+
+	if variable.Cache != "" && variable.Cache != entity.RunCache && variable.Cache != entity.SessionCache {
+		add(path+".cache", errors.Errorf("unknown cache %s", variable.Cache))
+	}
+
+	if variable.Cache != "" && variable.Cmd == "" {
+		add(path+".cache", errors.New("cache is only supported with cmd"))
+	}
+
+	if dynamicFlags[variable.Name] {
+		add(path, errors.New("name is already used by a dynamic flag"))
+	}
+}
+
+func trimNamespace(name string) string {
+	return strings.TrimPrefix(name, entity.VarTagNamespace+".")
+}
@@ -0,0 +1,317 @@
+package vars
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"project-helper/internal/config"
+	"project-helper/internal/domain/dto"
+	"project-helper/internal/domain/entity"
+	"project-helper/internal/service/tag/extractor"
+	"project-helper/internal/service/vars/mocks"
+)
+
+func TestGetVar(t *testing.T) {
+	t.Parallel()
+
+	controller := newTestController(gomock.NewController(t))
+	controller.configService.EXPECT().GetConfig().Return(&config.Application{
+		Vars: config.Vars{
+			{Name: "registry", Value: "registry.example.com"},
+			{Name: "cluster", Value: "dev"},
+		},
+	}).Times(4)
+
+	service := controller.Build()
+	operation := config.Operation{
+		Name: "deploy",
+		Vars: config.Vars{{Name: "cluster", Value: "prod"}},
+	}
+
+	variable, ok := service.GetVar(operation, "registry")
+	assert.True(t, ok)
+	assert.Equal(t, "registry.example.com", variable.Value)
+
+	variable, ok = service.GetVar(operation, "cluster")
+	assert.True(t, ok)
+	assert.Equal(t, "prod", variable.Value)
+
+	variable, ok = service.GetVar(operation, "var.cluster")
+	assert.True(t, ok)
+	assert.Equal(t, "prod", variable.Value)
+
+	_, ok = service.GetVar(operation, "unknown")
+	assert.False(t, ok)
+}
+
+func TestGetTagValue(t *testing.T) {
+	t.Parallel()
+
+	application := &config.Application{
+		Vars: config.Vars{
+			{Name: "image", Value: "${{registry}}/app"},
+			{Name: "greeting", Cmd: "echo '$${{name}}' ${{name}}"},
+		},
+	}
+
+	resolveTags := func(value string) (string, error) {
+		switch value {
+		case "${{registry}}/app":
+			return "registry.example.com/app", nil
+		case "echo '$${{name}}' ${{name}}":
+			return entity.ProtectEscapedTags("echo '$${{name}}' ") + "api", nil
+		default:
+			return "", assert.AnError
+		}
+	}
+
+	tests := map[string]struct {
+		preconditions func(*testController)
+		config        *config.Application
+		name          string
+		resolveTags   func(value string) (string, error)
+		expected      string
+		found         bool
+		expectedErr   error
+	}{
+		"value var": {
+			name:     "image",
+			expected: "registry.example.com/app",
+			found:    true,
+		},
+		"cmd var with escaped tag": {
+			preconditions: func(t *testController) {
+				t.configService.EXPECT().GetApplicationPath().Return("")
+			},
+			name:     "greeting",
+			expected: "${{name}} api",
+			found:    true,
+		},
+		"unknown var": {
+			name: "unknown",
+		},
+		"with failed resolution": {
+			config:      &config.Application{Vars: config.Vars{{Name: "broken", Value: "${{missing}}"}}},
+			name:        "broken",
+			found:       true,
+			expectedErr: errors.New("failed to resolve var broken: assert.AnError general error for testing"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+
+			if testCase.config != nil {
+				controller.configService.EXPECT().GetConfig().Return(testCase.config)
+			} else {
+				controller.configService.EXPECT().GetConfig().Return(application)
+			}
+
+			if testCase.preconditions != nil {
+				testCase.preconditions(controller)
+			}
+
+			request := &dto.GetTagValueRequest{Operation: config.Operation{Name: "deploy"}, ResolveTags: resolveTags}
+
+			value, found, err := controller.Build().GetTagValue(request, testCase.name)
+
+			assert.Equal(t, testCase.found, found)
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, value)
+			}
+		})
+	}
+}
+
+func TestGetCmdValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		preconditions func(*testController, string)
+		variable      config.Var
+		expected      string
+		expectedErr   error
+	}{
+		"run cache": {
+			preconditions: func(t *testController, dir string) {
+				t.configService.EXPECT().GetApplicationPath().Return(dir)
+			},
+			variable: config.Var{Name: "count", Cmd: "echo run >> counter; wc -l < counter", Cache: entity.RunCache},
+			expected: "1",
+		},
+		"session cache hit": {
+			preconditions: func(t *testController, _ string) {
+				t.stateService.EXPECT().GetSessionVars(gomock.Any()).
+					Return(map[string]string{"echo run >> counter; wc -l < counter": "5"}, nil)
+			},
+			variable: config.Var{Name: "count", Cmd: "echo run >> counter; wc -l < counter", Cache: entity.SessionCache},
+			expected: "5",
+		},
+		"session cache miss": {
+			preconditions: func(t *testController, dir string) {
+				t.stateService.EXPECT().GetSessionVars(gomock.Any()).Return(map[string]string{}, nil)
+				t.configService.EXPECT().GetApplicationPath().Return(dir)
+				t.stateService.EXPECT().SaveSessionVars(gomock.Any(), map[string]string{"echo run >> counter; wc -l < counter": "1"}).
+					Return(nil)
+			},
+			variable: config.Var{Name: "count", Cmd: "echo run >> counter; wc -l < counter", Cache: entity.SessionCache},
+			expected: "1",
+		},
+		"with failed command": {
+			preconditions: func(t *testController, dir string) {
+				t.configService.EXPECT().GetApplicationPath().Return(dir)
+			},
+			variable:    config.Var{Name: "broken", Cmd: "exit 3"},
+			expectedErr: errors.New("failed to run command of var broken: failed to run command: exit status 3"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+			testCase.preconditions(controller, t.TempDir())
+
+			service := controller.Build()
+
+			for range 2 {
+				value, err := service.GetCmdValue(testCase.variable, testCase.variable.Cmd)
+
+				if testCase.expectedErr != nil {
+					require.Error(t, err)
+					assert.ErrorContains(t, err, testCase.expectedErr.Error())
+
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, value)
+			}
+		})
+	}
+}
+
+func TestValidateVars(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config      *config.Application
+		expectedErr error
+	}{
+		"valid": {
+			config: &config.Application{
+				Vars: config.Vars{
+					{Name: "registry", Value: "registry.example.com"},
+					{Name: "image", Value: "${{registry}}/${{name}}:${{version}}"},
+					{Name: "version", Cmd: "git describe", Cache: entity.SessionCache},
+				},
+				Operations: config.Operations{
+					{Name: "deploy", Vars: config.Vars{{Name: "registry", Value: "${{env.REGISTRY}}"}}},
+				},
+			},
+		},
+		"with cycle": {
+			config: &config.Application{
+				Vars: config.Vars{
+					{Name: "first", Value: "${{second}}"},
+					{Name: "second", Value: "${{first | upper}}"},
+				},
+			},
+			expectedErr: errors.New("vars[first]: vars form a cycle: first -> second -> first"),
+		},
+		"with cycle through operation vars": {
+			config: &config.Application{
+				Vars: config.Vars{{Name: "image", Value: "${{registry}}/app"}},
+				Operations: config.Operations{
+					{Name: "deploy", Vars: config.Vars{{Name: "registry", Value: "${{image}}"}}},
+				},
+			},
+			expectedErr: errors.New("vars[image]: vars form a cycle: image -> registry -> image"),
+		},
+		"with value and cmd": {
+			config: &config.Application{
+				Vars: config.Vars{{Name: "version", Value: "1", Cmd: "git describe"}},
+			},
+			expectedErr: errors.New("vars[version]: var version is not valid: value and cmd are mutually exclusive"),
+		},
+		"with unknown cache": {
+			config: &config.Application{
+				Vars: config.Vars{{Name: "version", Cmd: "git describe", Cache: "forever"}},
+			},
+			expectedErr: errors.New("vars[version].cache: var version is not valid: unknown cache forever"),
+		},
+		"with dynamic flag name": {
+			config: &config.Application{
+				DynamicFlags: config.DynamicFlags{{Name: "env", Type: entity.String}},
+				Operations: config.Operations{
+					{Name: "deploy", Vars: config.Vars{{Name: "env", Value: "prod"}}},
+				},
+			},
+			expectedErr: errors.New("operations[deploy].vars[env]: var env is not valid: name is already used by a dynamic flag"),
+		},
+		"with several problems": {
+			config: &config.Application{
+				Vars: config.Vars{
+					{Name: "version", Value: "1", Cmd: "git describe"},
+					{Name: "sha", Value: "abc", Cache: "forever"},
+					{Name: "first", Value: "${{second}}"},
+					{Name: "second", Value: "${{first}}"},
+				},
+				Operations: config.Operations{
+					{Name: "deploy", Vars: config.Vars{{Name: "tag", Value: "${{var.tag}}"}}},
+				},
+			},
+			expectedErr: errors.New("vars[version]: var version is not valid: value and cmd are mutually exclusive; " +
+				"vars[sha].cache: var sha is not valid: unknown cache forever; " +
+				"vars[sha].cache: var sha is not valid: cache is only supported with cmd; " +
+				"vars[first]: vars form a cycle: first -> second -> first; " +
+				"operations[deploy].vars[tag]: vars form a cycle: tag -> tag"),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			controller := newTestController(gomock.NewController(t))
+			controller.configService.EXPECT().GetConfig().Return(testCase.config)
+
+			err := controller.Build().ValidateVars()
+
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type testController struct {
+	configService *mocks.MockConfigService
+	stateService  *mocks.MockStateService
+}
+
+func newTestController(ctrl *gomock.Controller) *testController {
+	return &testController{
+		configService: mocks.NewMockConfigService(ctrl),
+		stateService:  mocks.NewMockStateService(ctrl),
+	}
+}
+
+func (t *testController) Build() *Service {
+	return NewService(t.configService, t.stateService, extractor.NewService())
+}
@@ -13,6 +13,8 @@ func MakePointer[v any](s v) *v {
 	return &s
 }
 
+// EscapeValue strips one level of Go quoting when the whole value is a Go string or rune literal, for example
+// `"a b"` becomes `a b` and `'x'` becomes `x`. Any other value, such as `'value'`, is returned unchanged.
 func EscapeValue(value string) (string, error) {
 	unquote, err := strconv.Unquote(value)
 	if err != nil && errors.Is(err, strconv.ErrSyntax) {
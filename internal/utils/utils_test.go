@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    string
+		expected string
+	}{
+		"plain value": {
+			value:    "value",
+			expected: "value",
+		},
+		"double quoted value": {
+			value:    `"hello world"`,
+			expected: "hello world",
+		},
+		"double quoted value with escapes": {
+			value:    `"tab\tand \"quotes\""`,
+			expected: "tab\tand \"quotes\"",
+		},
+		"back quoted value": {
+			value:    "`raw \\n`",
+			expected: `raw \n`,
+		},
+		"single quoted value": {
+			value:    `'value'`,
+			expected: `'value'`,
+		},
+		"single quoted character": {
+			value:    `'x'`,
+			expected: `x`,
+		},
+		"single quoted escape": {
+			value:    `'\n'`,
+			expected: "\n",
+		},
+		"double quoted backslash": {
+			value:    `"a\\b"`,
+			expected: `a\b`,
+		},
+		"partially quoted value": {
+			value:    `--name="value"`,
+			expected: `--name="value"`,
+		},
+		"unbalanced quote": {
+			value:    `"value`,
+			expected: `"value`,
+		},
+		"tag-like value": {
+			value:    "${{name}}",
+			expected: "${{name}}",
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := EscapeValue(testCase.value)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, value)
+		})
+	}
+}